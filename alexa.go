@@ -1,8 +1,13 @@
 package alexa
 
 import (
+	"context"
+	"net/http"
+	"time"
+
 	"github.com/dasjott/alexa-sdk-go/api"
 	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/dasjott/alexa-sdk-go/verify"
 )
 
 // AppID is the ID of the corresponding skill
@@ -18,54 +23,39 @@ var LocaleStrings Localisation
 // You must provide the according Translation.
 var GetTranslation func(locale string) Translation
 
+// DefaultLocale is used to translate for the ErrorHandler, if the locale of a request is not supported.
+// Leave it empty for no translations then, so c.T returns empty strings.
+var DefaultLocale string
+
 // BeforeHandler can be set with a function to implement any checking before every intent.
 // It returns true for going on with the actual intent or false to skip.
 // Remember to implement a appropriate message to the user on skipping!
 var BeforeHandler func(*Context)
 
-// Handle is the function you hand over to the lambda.start
-var Handle = func(req *dialog.EchoRequest) (*dialog.EchoResponse, error) {
-	if req == nil {
-		panic("Echo request is nil")
-	}
+// TimestampTolerance is the maximum age of a request. Leave it zero to skip that check.
+// Amazon requires 150 seconds for skills hosted outside of Lambda.
+var TimestampTolerance time.Duration
 
-	// if !req.VerifyTimestamp() {
-	// 	return "", errors.New("invalid timestamp")
-	// }
-	if AppID != "" && !req.VerifyAppID(AppID) {
-		panic("invalid app id")
-	}
-	if Handlers == nil {
-		panic("no handlers set")
-	}
+// Verifier checks the signature of requests handed over to HandleRaw. Leave it nil to skip that check.
+// Use verify.New() for a Verifier loading and caching the certificates from Amazon.
+var Verifier *verify.Verifier
 
-	var trans *Translator
-	if GetTranslation != nil {
-		if langmap := GetTranslation(req.Request.Locale); langmap != nil {
-			loc := Localisation{req.Request.Locale: langmap}
-			trans = loc.GetTranslator(req.Request.Locale)
-		}
-	} else if LocaleStrings != nil {
-		trans = LocaleStrings.GetTranslator(req.Request.Locale)
-	}
-	if trans == nil {
-		panic("language " + req.Request.Locale + " not implemented")
-	}
-
-	c := Context{
-		request:    req,
-		handlers:   Handlers,
-		response:   dialog.NewResponse(),
-		translator: trans,
-		attributes: req.Session.Attributes,
+// ErrorHandler is called, if a request could not be handled, e.g. for a missing handler or an unsupported locale.
+// The Context holds a fresh response, so you can still apologize to the user.
+// If not set, the error is returned by Handle instead.
+var ErrorHandler func(c *Context, err error)
 
-		System: &req.Context.System,
-		Intent: &req.Request.Intent,
-		Time:   req.GetTime(),
-	}
+// Handle is the function you hand over to the lambda.start
+// It handles the request with the skill set up by the package variables.
+// The context is available as Context.Ctx() and cancels api calls and progressive responses.
+var Handle = func(ctx context.Context, req *dialog.EchoRequest) (*dialog.EchoResponse, error) {
+	return defaultSkill().Handle(ctx, req)
+}
 
-	c.start(req)
-	return c.getResult()
+// HandleRaw verifies the signature headers of a raw request body, if a Verifier is set, and then calls Handle.
+// Use this, if you do not host your skill on Lambda.
+func HandleRaw(ctx context.Context, header http.Header, body []byte) (*dialog.EchoResponse, error) {
+	return defaultSkill().HandleRaw(ctx, header, body)
 }
 
 // IntentHandler function for the handler
@@ -91,5 +81,5 @@ func MultiHandler(handlers ...IntentHandler) IntentHandler {
 
 // API sets up a client to call the alexa api
 func API(c *Context) *api.Client {
-	return api.NewClient(&c.request.Context.System)
+	return api.NewClient(&c.request.Context.System).WithHTTPClient(c.skill.HTTPClient).WithContext(c.Ctx())
 }
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -18,7 +19,9 @@ const (
 // Client is the client to use the alexa api
 // get an instance by using NewClient
 type Client struct {
-	sys *dialog.EchoSystem
+	sys    *dialog.EchoSystem
+	client *http.Client
+	ctx    context.Context
 }
 
 // NewClient creates an instance of Client with given setup
@@ -28,24 +31,44 @@ func NewClient(esys *dialog.EchoSystem) *Client {
 	}
 }
 
+// WithHTTPClient sets the client to send requests with. If nil, http.DefaultClient is used.
+func (c *Client) WithHTTPClient(client *http.Client) *Client {
+	c.client = client
+	return c
+}
+
+// WithContext sets the context of all requests, e.g. to cancel them on the deadline of the skills response
+func (c *Client) WithContext(ctx context.Context) *Client {
+	c.ctx = ctx
+	return c
+}
+
 // Request to be called with string containing {deviceId}
 // Please check the constants from this package
 func (c *Client) Request(path string) (string, error) {
 	url := c.GetDevicePath(path)
-	req, err := http.NewRequest(http.MethodGet, url, nil)
-	req.Header.Add("Authorization", "Bearer "+c.sys.APIAccessToken)
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 
 	if err == nil {
+		req.Header.Add("Authorization", "Bearer "+c.sys.APIAccessToken)
 		var resp *http.Response
 
-		if test.RequestHandler != nil {
+		if c.client != nil {
+			resp, err = c.client.Do(req)
+		} else if test.RequestHandler != nil {
 			resp = test.RequestHandler(req)
 		} else {
-			client := http.Client{}
-			resp, err = client.Do(req)
+			resp, err = http.DefaultClient.Do(req)
 		}
 
 		if err == nil && resp != nil {
+			if resp.Body != nil {
+				defer resp.Body.Close()
+			}
 			if resp.StatusCode != 200 {
 				err = fmt.Errorf("response code %d", resp.StatusCode)
 			} else {
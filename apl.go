@@ -0,0 +1,77 @@
+package alexa
+
+import (
+	"strconv"
+
+	"github.com/dasjott/alexa-sdk-go/dialog"
+)
+
+// UserEvent is sent by the APL SendEvent command, e.g. on touching a component
+type UserEvent struct {
+	// Token is the token of the document
+	Token string
+	// Arguments are the arguments of the SendEvent command
+	Arguments []interface{}
+	// Source is the component that sent the event
+	Source *dialog.APLSource
+	// Components are the values of components, as requested by the SendEvent command
+	Components map[string]interface{}
+}
+
+// Command is the first argument, if it is a string.
+// It is used to find the handler for the event.
+func (e *UserEvent) Command() string {
+	if len(e.Arguments) > 0 {
+		if cmd, ok := e.Arguments[0].(string); ok {
+			return cmd
+		}
+	}
+	return ""
+}
+
+// Argument gets the argument at index i. The pointer is never nil.
+func (e *UserEvent) Argument(i int) *Attr {
+	if i < 0 || i >= len(e.Arguments) {
+		return &Attr{name: strconv.Itoa(i)}
+	}
+	return &Attr{
+		name:   strconv.Itoa(i),
+		val:    e.Arguments[i],
+		exists: true,
+	}
+}
+
+// UserEvent gets the event of an Alexa.Presentation.APL.UserEvent request.
+// It is nil for any other request, so you can tell touch from voice.
+func (c *Context) UserEvent() *UserEvent {
+	body := &c.request.Request
+	if body.Type != dialog.APLUserEvent {
+		return nil
+	}
+	return &UserEvent{
+		Token:      body.Token,
+		Arguments:  body.Arguments,
+		Source:     body.Source,
+		Components: body.Components,
+	}
+}
+
+// RenderDocument renders an APL document on the devices screen.
+// The directive is only sent, if the device supports APL. The return value tells whether it was sent.
+func (c *Context) RenderDocument(token string, document interface{}, datasources map[string]interface{}) bool {
+	if !c.Supports(dialog.InterfaceAPL) {
+		return false
+	}
+	c.response.RenderDocument(token, document, datasources)
+	return true
+}
+
+// ExecuteCommands runs APL commands on the document rendered with the given token.
+// The directive is only sent, if the device supports APL. The return value tells whether it was sent.
+func (c *Context) ExecuteCommands(token string, commands ...interface{}) bool {
+	if !c.Supports(dialog.InterfaceAPL) {
+		return false
+	}
+	c.response.ExecuteCommands(token, commands...)
+	return true
+}
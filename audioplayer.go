@@ -0,0 +1,46 @@
+package alexa
+
+import (
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go/dialog"
+)
+
+// playbackIntents maps PlaybackController requests to the intents of the same meaning.
+// If there is no handler for the request, the handler of the intent is called.
+var playbackIntents = map[string]string{
+	dialog.PlaybackControllerNext:     "AMAZON.NextIntent",
+	dialog.PlaybackControllerPrevious: "AMAZON.PreviousIntent",
+	dialog.PlaybackControllerPlay:     "AMAZON.ResumeIntent",
+	dialog.PlaybackControllerPause:    "AMAZON.PauseIntent",
+}
+
+// AudioPlayer is the state of the AudioPlayer on the device
+type AudioPlayer struct {
+	// Token identifies the stream, as given to AudioPlay
+	Token string
+	// Offset is the position within the stream
+	Offset time.Duration
+	// PlayerActivity is one of the dialog.PlayerActivity constants
+	PlayerActivity string
+	// Error is only set for AudioPlayer.PlaybackFailed requests
+	Error *dialog.EchoError
+}
+
+// AudioPlayer gets the state of the AudioPlayer.
+// For AudioPlayer requests, token and offset are the ones of the request, otherwise of the players context.
+func (c *Context) AudioPlayer() *AudioPlayer {
+	player := &AudioPlayer{}
+	if ap := c.request.Context.AudioPlayer; ap != nil {
+		player.Token = ap.Token
+		player.Offset = time.Duration(ap.OffsetInMilliseconds) * time.Millisecond
+		player.PlayerActivity = ap.PlayerActivity
+	}
+	if c.request.IsAudioPlayer() {
+		body := &c.request.Request
+		player.Token = body.Token
+		player.Offset = time.Duration(body.OffsetInMilliseconds) * time.Millisecond
+		player.Error = body.Error
+	}
+	return player
+}
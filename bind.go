@@ -0,0 +1,172 @@
+package alexa
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	durationType  = reflect.TypeOf(time.Duration(0))
+	dateRangeType = reflect.TypeOf(DateRange{})
+	timeOfDayType = reflect.TypeOf(TimeOfDay{})
+	slotType      = reflect.TypeOf(Slot{})
+)
+
+// BindSlots fills the fields of the struct v points to with the slots of the intent.
+// As tag name use alexa, with the name of the slot and the options "required" and "id":
+//
+//	type Booking struct {
+//		City   string           `alexa:"city,required"`
+//		CityID string           `alexa:"city,id"`
+//		Guests int              `alexa:"guests"`
+//		Date   *alexa.DateRange `alexa:"date,required"`
+//		Stay   time.Duration    `alexa:"stay"`
+//	}
+//
+// Fields may be string, all kinds of ints and floats, time.Duration, DateRange, TimeOfDay and Slot, also as pointers,
+// and slices of strings for multi value slots. Strings get the resolved value, or the resolved ID with the id option.
+// Fields without tag are ignored.
+//
+// Empty slots and slots Alexa did not understand are left untouched. The names of the ones which are required
+// are returned, in the order of the fields. Pass the first one to ElicitSlot.
+// Values which can not be parsed cause an error, matching ErrInvalidValue with errors.Is.
+func (c *Context) BindSlots(v interface{}) ([]string, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return nil, errors.New("BindSlots needs a pointer to a struct")
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	var missing []string
+	for i := 0; i < rt.NumField(); i++ {
+		tf := rt.Field(i)
+		tag := tf.Tag.Get("alexa")
+		if tag == "" || tf.PkgPath != "" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		name := parts[0]
+		required, id := false, false
+		for _, opt := range parts[1:] {
+			switch opt {
+			case "required":
+				required = true
+			case "id":
+				id = true
+			}
+		}
+
+		slot := c.Slot(name)
+		if slot.Empty() || slot.Spoken == "?" {
+			if required && !contains(missing, name) {
+				missing = append(missing, name)
+			}
+			continue
+		}
+		if err := bindSlot(rv.Field(i), slot, id); err != nil {
+			return missing, fmt.Errorf("slot %s: %w", name, err)
+		}
+	}
+	return missing, nil
+}
+
+func bindSlot(field reflect.Value, slot *Slot, id bool) error {
+	if field.Kind() == reflect.Ptr {
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		field = field.Elem()
+	}
+
+	switch field.Type() {
+	case durationType:
+		d, err := slot.Duration()
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(d))
+		return nil
+	case dateRangeType:
+		r, err := slot.Date()
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(*r))
+		return nil
+	case timeOfDayType:
+		t, err := slot.TimeOfDay()
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(*t))
+		return nil
+	case slotType:
+		field.Set(reflect.ValueOf(*slot))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(slotString(slot, id))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := slot.Int()
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := slot.Int()
+		if err != nil {
+			return err
+		}
+		if n < 0 {
+			return fmt.Errorf("%w: negative number %d", ErrInvalidValue, n)
+		}
+		field.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		f, err := slot.Float()
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Slice:
+		if field.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported field type %s", field.Type())
+		}
+		items := slot.Items
+		if items == nil {
+			items = []*Slot{slot}
+		}
+		values := reflect.MakeSlice(field.Type(), 0, len(items))
+		for _, item := range items {
+			values = reflect.Append(values, reflect.ValueOf(slotString(item, id)).Convert(field.Type().Elem()))
+		}
+		field.Set(values)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+func slotString(slot *Slot, id bool) string {
+	if id {
+		return slot.ID
+	}
+	if slot.Value == "" {
+		return slot.Spoken
+	}
+	return slot.Value
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,126 @@
+package alexa
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go/dialog"
+)
+
+// deadlineMargin is the time left before the deadline of the context, to send the fallback response
+const deadlineMargin = 250 * time.Millisecond
+
+// states of a Context, so either the handler or the fallback finishes the request
+const (
+	handling int32 = iota
+	finishing
+	abandoned
+)
+
+type result struct {
+	response *dialog.EchoResponse
+	err      error
+}
+
+// budgeted runs the handler in a goroutine of its own, to send a progressive response after ProgressAfter
+// and to answer with the fallback after the TimeBudget, if the handler takes that long.
+// A deadline of the context shortens the TimeBudget, leaving the time to respond.
+func (c *Context) budgeted(req *dialog.EchoRequest) (*dialog.EchoResponse, error) {
+	// the fallback shares nothing the handler may change
+	fallback := c.fallback()
+
+	limit := c.skill.TimeBudget
+	if deadline, ok := c.Ctx().Deadline(); ok && limit > 0 {
+		if left := time.Until(deadline) - deadlineMargin; left < limit {
+			limit = left
+		}
+	}
+
+	ctx, cancel := context.WithCancel(c.Ctx())
+	defer cancel()
+	c.ctx = ctx
+
+	var budget, progress <-chan time.Time
+	if c.skill.TimeBudget > 0 {
+		timer := time.NewTimer(limit)
+		defer timer.Stop()
+		budget = timer.C
+	}
+	if c.skill.ProgressAfter > 0 && c.skill.ProgressKey != "" {
+		timer := time.NewTimer(c.skill.ProgressAfter)
+		defer timer.Stop()
+		progress = timer.C
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		c.start(req)
+		resp, err := c.getResult()
+		done <- result{resp, err}
+	}()
+
+	var auto *dialog.ProgressRequest
+	for {
+		select {
+		case r := <-done:
+			if auto != nil {
+				auto.Wait()
+			}
+			return r.response, r.err
+		case <-progress:
+			progress = nil
+			auto = fallback.autoProgress(ctx)
+		case <-budget:
+			budget = nil
+			if !atomic.CompareAndSwapInt32(&c.state, handling, abandoned) {
+				// the handler is finishing already, so its response is the one
+				continue
+			}
+			cancel()
+			if auto != nil {
+				auto.Wait()
+			}
+			return fallback.timeout()
+		}
+	}
+}
+
+// fallback creates a context for the fallback response, with a response, a translator,
+// session and persistent attributes of its own
+func (c *Context) fallback() *Context {
+	fb := *c
+	fb.response = dialog.NewResponse().SetVoice(c.voice)
+	fb.translator = c.skill.translator(c.Locale())
+	fb.persistent, fb.persistentChanged = nil, false
+	fb.attributes = make(attributes, len(c.attributes))
+	for k, v := range c.attributes {
+		fb.attributes[k] = v
+	}
+	return &fb
+}
+
+// autoProgress sends the progressive response of the skill
+func (c *Context) autoProgress(ctx context.Context) *dialog.ProgressRequest {
+	p := dialog.NewProgressRequest(c.T(c.skill.ProgressKey), c.request.Request.RequestID, c.System)
+	if p != nil {
+		if c.voice != "" {
+			p.SetVoice(c.voice)
+		}
+		p.WithHTTPClient(c.skill.HTTPClient).WithContext(ctx).Send()
+	}
+	return p
+}
+
+// timeout responds with the translation of TimeoutKey, keeping the session open to ask again.
+// Requests not allowing speech get an empty response. The response interceptors run as for any response.
+func (c *Context) timeout() (*dialog.EchoResponse, error) {
+	speaks := !c.request.IsAudioPlayer() && !c.request.IsPlaybackController() &&
+		c.request.GetRequestType() != dialog.SessionEndedRequest &&
+		c.request.GetRequestType() != dialog.CanFulfillIntentRequest
+	if speaks {
+		speech := c.T(c.skill.TimeoutKey)
+		c.Ask(speech, speech)
+	}
+	return c.getResult()
+}
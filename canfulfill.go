@@ -0,0 +1,70 @@
+package alexa
+
+import "github.com/dasjott/alexa-sdk-go/dialog"
+
+// CanFulfillIntent answers a CanFulfillIntentRequest from the Handlers, the Routes and the Validation of the skill.
+// The intent can be fulfilled, if there is a handler for it and all of its slots pass the validation.
+// Slots are understood, if they match a value of their slot type. No handler is called for this.
+func (c *Context) CanFulfillIntent() *dialog.CanFulfillIntent {
+	answer := &dialog.CanFulfillIntent{CanFulfill: dialog.CanFulfillNo}
+	if !c.knowsIntent() {
+		return answer
+	}
+
+	answer.CanFulfill = dialog.CanFulfillYes
+	for name := range c.Intent.Slots {
+		slot := c.Slot(name)
+		if slot.Empty() {
+			continue
+		}
+		if answer.Slots == nil {
+			answer.Slots = make(map[string]dialog.CanFulfillSlot)
+		}
+
+		result := dialog.CanFulfillSlot{CanUnderstand: dialog.CanFulfillYes, CanFulfill: dialog.CanFulfillYes}
+		if len(slot.Authorities) > 0 && !slot.Match {
+			result.CanUnderstand = dialog.CanFulfillMaybe
+			if answer.CanFulfill == dialog.CanFulfillYes {
+				answer.CanFulfill = dialog.CanFulfillMaybe
+			}
+		}
+		for _, rule := range c.skill.Validation[c.Intent.Name] {
+			if rule.Slot == name && !rule.check(slot) {
+				result.CanFulfill = dialog.CanFulfillNo
+				answer.CanFulfill = dialog.CanFulfillNo
+			}
+		}
+		answer.Slots[name] = result
+	}
+	return answer
+}
+
+// CanFulfill answers a CanFulfillIntentRequest. Use it within a handler for "CanFulfillIntentRequest",
+// if the answer of CanFulfillIntent is not enough.
+func (c *Context) CanFulfill(answer *dialog.CanFulfillIntent) {
+	c.response.CanFulfill(answer)
+}
+
+// knowsIntent determines whether there is a handler or a route for the intent asked for
+func (c *Context) knowsIntent() bool {
+	name := c.Intent.Name
+	if _, exists := c.skill.Handlers[name]; exists {
+		return true
+	}
+
+	// routes are asked as if it were the intent request itself
+	req := *c.request
+	req.Request.Type = "IntentRequest"
+	probe := *c
+	probe.request = &req
+	return probe.route() != nil
+}
+
+// canFulfillResult answers a CanFulfillIntentRequest declaratively, without running any handler or interceptor
+func (c *Context) canFulfillResult() (*dialog.EchoResponse, error) {
+	c.CanFulfill(c.CanFulfillIntent())
+	if err := c.finish(); err != nil {
+		return nil, err
+	}
+	return c.response, nil
+}
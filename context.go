@@ -1,26 +1,30 @@
 package alexa
 
 import (
+	"context"
 	"fmt"
-	"math/rand"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/dasjott/alexa-sdk-go/dialog"
 )
 
-var random *rand.Rand
-
 // Context is the object sent to every intent, collecting infos for response
 type Context struct {
 	attributes
-	request    *dialog.EchoRequest
-	handlers   IntentHandlers
-	response   *dialog.EchoResponse
-	translator *Translator
-	err        error
-	abort      bool
-	progress   *dialog.ProgressRequest
+	persistent        attributes
+	persistentChanged bool
+	skill             *Skill
+	ctx               context.Context
+	request           *dialog.EchoRequest
+	response          *dialog.EchoResponse
+	translator        *Translator
+	err               error
+	abort             bool
+	progress          *dialog.ProgressRequest
+	voice             string
+	state             int32 // handling, finishing or abandoned, accessed atomically
 	// System contains informations about the calling Device and User
 	System *dialog.EchoSystem
 	// Intent is the intents name
@@ -30,38 +34,122 @@ type Context struct {
 }
 
 func (c *Context) start(req *dialog.EchoRequest) {
-	if c.translator == nil {
-		panic("no translator set")
-	}
 	if c.attributes == nil {
 		c.attributes = make(attributes)
 	}
 
-	random = rand.New(rand.NewSource(time.Now().Unix()))
+	c.safely(func() {
+		if c.skill.BeforeHandler != nil {
+			c.skill.BeforeHandler(c)
+		}
+		if !c.abort && c.interceptRequest() {
+			c.onIntent(req.GetIntentName())
+		}
+	})
 
-	if BeforeHandler != nil {
-		BeforeHandler(c)
-	}
-	if !c.abort {
-		c.onIntent(req.GetIntentName())
+	// the hook is called even if the handler failed, which is when the reason matters most
+	err := c.err
+	c.safely(c.sessionEnded)
+	if err != nil {
+		c.err = err
 	}
 }
 
 func (c *Context) onIntent(name string) {
 	fmt.Printf("intent: %s\n", name)
-	if handler, exists := c.handlers[name]; exists {
-		handler(c)
-	} else if handler, exists := c.handlers["Unhandled"]; exists {
+	if handler := c.handler(name); handler != nil {
+		if rule := c.invalidSlot(); rule != nil {
+			c.elicitInvalid(rule)
+			return
+		}
 		handler(c)
-	} else {
-		panic("no handler found")
+	} else if name != dialog.SessionEndedRequest {
+		c.err = &HandlerError{name}
 	}
 }
 
+// handler finds the handler for the request named name.
+// The first matching route comes first.
+// UserEvents are handled by the handler named by their command, if there is one.
+// PlaybackController requests fall back to the handlers of the according intents.
+func (c *Context) handler(name string) IntentHandler {
+	if handler := c.route(); handler != nil {
+		return handler
+	}
+	if ev := c.UserEvent(); ev != nil && ev.Command() != "" {
+		if handler, exists := c.skill.Handlers[ev.Command()]; exists {
+			return handler
+		}
+	}
+	if handler, exists := c.skill.Handlers[name]; exists {
+		return handler
+	}
+	if intent, exists := playbackIntents[name]; exists {
+		if handler, exists := c.skill.Handlers[intent]; exists {
+			return handler
+		}
+	}
+	return c.skill.Handlers["Unhandled"]
+}
+
 func (c *Context) getResult() (*dialog.EchoResponse, error) {
+	if !atomic.CompareAndSwapInt32(&c.state, handling, finishing) {
+		// the fallback responded already, so nothing of the handler takes effect.
+		// A progress is cancelled with the context, waiting for it does not take long.
+		c.progressWait()
+		return nil, context.Canceled
+	}
 	c.progressWait()
+	if c.err == nil {
+		c.safely(c.interceptResponse)
+		c.progressWait()
+	}
+	if c.err == nil {
+		c.err = c.savePersistent()
+	}
+	if c.err == nil {
+		c.err = c.finish()
+	}
+	if c.err != nil {
+		handle := c.recovery(c.err)
+		if handle == nil {
+			return nil, c.err
+		}
+		err := c.err
+		c.err = nil
+		c.response = dialog.NewResponse().SetVoice(c.voice)
+		c.safely(func() { handle(c, err) })
+		c.progressWait()
+		if c.err == nil {
+			// the interceptors see the response of the recovery as well
+			c.safely(c.interceptResponse)
+			c.progressWait()
+		}
+		if c.err != nil {
+			return nil, c.err
+		}
+		if err = c.finish(); err != nil {
+			return nil, err
+		}
+	}
+	return c.response, nil
+}
+
+// finish completes the response according to the request type and validates it
+func (c *Context) finish() error {
+	if c.attributes == nil {
+		c.attributes = make(attributes)
+	}
+	if c.request.GetRequestType() == dialog.SessionEndedRequest {
+		// Alexa does not accept any response here
+		c.response = dialog.NewResponse().NoSession()
+		return nil
+	}
 	c.response.SessionAttributes = c.attributes
-	return c.response, c.err
+	if c.request.IsAudioPlayer() || c.request.IsPlaybackController() {
+		c.response.AudioOnly()
+	}
+	return c.response.Validate()
 }
 
 func (c *Context) progressWait() {
@@ -75,10 +163,10 @@ func (c *Context) progressWait() {
 func (c *Context) Slot(name string) *Slot {
 	if c.request.Request.Intent.Slots != nil {
 		if slot, ok := c.request.Request.Intent.Slots[name]; ok {
-			return slotFromEchoSlot(&slot)
+			return slotFromEchoSlot(&slot).setTime(c.Time)
 		}
 	}
-	return &Slot{}
+	return &Slot{now: c.Time}
 }
 
 // NewSession determines whether this is a new session that was opened with this call
@@ -134,24 +222,48 @@ func (c *Context) Tell(speech string) *Cardable {
 func (c *Context) Ask(speechOutput string, repromptSpeech ...string) *Cardable {
 	c.response.OutputSSML(speechOutput)
 	if count := len(repromptSpeech); count > 0 {
-		reprompt := repromptSpeech[random.Intn(count)]
+		reprompt := repromptSpeech[c.translator.intn(count)]
 		c.response.RepromptSSML(reprompt)
 	}
 	return &Cardable{c}
 }
 
-// AudioPlay is for AudioPlayer.Play
+// AudioPlay starts playing the audio at url immediately, replacing the queue.
+// The id is the token to identify the stream in AudioPlayer requests. Leave speech empty to play without saying anything.
 func (c *Context) AudioPlay(audio, id, speech string) *Cardable {
-	// c.response.OutputSSML(speech).AudioDirective("AudioPlayer.Play", "REPLACE_ALL")
+	return c.AudioPlayStream(dialog.PlayBehaviorReplaceAll, dialog.AudioStream{Url: audio, Token: id}, nil, speech)
+}
+
+// AudioEnqueue adds the audio at url to the end of the queue.
+// The previousID must be the token of the stream currently playing.
+func (c *Context) AudioEnqueue(audio, id, previousID string) {
+	c.AudioPlayStream(dialog.PlayBehaviorEnqueue, dialog.AudioStream{Url: audio, Token: id, ExpectedPreviousToken: previousID}, nil, "")
+}
+
+// AudioPlayStream is for AudioPlayer.Play with all options, e.g. an offset or metadata with images.
+// Use one of the dialog.PlayBehavior constants. Leave speech empty to play without saying anything.
+func (c *Context) AudioPlayStream(behavior string, stream dialog.AudioStream, meta *dialog.AudioMetadata, speech string) *Cardable {
+	if speech != "" {
+		c.response.OutputSSML(speech)
+	}
+	c.response.EndSession().AudioPlay(behavior, stream, meta)
 	return &Cardable{c}
 }
 
-// AudioStop is for AudioPlayer.Stop, AudioPlayer.ClearQueue
+// AudioStop is for AudioPlayer.Stop. Leave speech empty to stop without saying anything.
 func (c *Context) AudioStop(speech string) *Cardable {
-	// c.response.OutputSSML(speech).AudioDirective("AudioPlayer.Stop", "")
+	if speech != "" {
+		c.response.OutputSSML(speech)
+	}
+	c.response.EndSession().AudioStop()
 	return &Cardable{c}
 }
 
+// AudioClearQueue is for AudioPlayer.ClearQueue. Use one of the dialog.ClearBehavior constants.
+func (c *Context) AudioClearQueue(behavior string) {
+	c.response.AudioClearQueue(behavior)
+}
+
 // ElicitSlot action to fullfill a slot of a certain intent
 func (c *Context) ElicitSlot(slotToElicit, speechOutput, repromptSpeech string, updatedIntent *dialog.EchoIntent) *Cardable {
 	c.response.OutputSSML(speechOutput).RepromptSSML(repromptSpeech).SlotDirective("Dialog.ElicitSlot", slotToElicit, "", updatedIntent)
@@ -175,13 +287,41 @@ func (c *Context) Delegate(updatedIntent *dialog.EchoIntent) {
 	c.response.SlotDirective("Dialog.Delegate", "", "", updatedIntent)
 }
 
+// ReplaceDynamicEntities replaces the values of slot types for the rest of the session
+func (c *Context) ReplaceDynamicEntities(types ...dialog.EntityListItem) {
+	c.response.UpdateDynamicEntities(dialog.UpdateBehaviorReplace, types...)
+}
+
+// ClearDynamicEntities removes all values added by ReplaceDynamicEntities
+func (c *Context) ClearDynamicEntities() {
+	c.response.UpdateDynamicEntities(dialog.UpdateBehaviorClear)
+}
+
 // Progress sends a progress for the user to be entertained while waiting
 func (c *Context) Progress(speech string) {
 	c.progressWait()
 	c.progress = dialog.NewProgressRequest(speech, c.request.Request.RequestID, c.System)
 	if c.progress != nil {
-		c.progress.Send()
+		if c.voice != "" {
+			c.progress.SetVoice(c.voice)
+		}
+		c.progress.WithHTTPClient(c.skill.HTTPClient).WithContext(c.Ctx()).Send()
+	}
+}
+
+// SetVoice sets a voice to be used for all following output of this response, including progresses.
+func (c *Context) SetVoice(name string) {
+	c.voice = name
+	c.response.SetVoice(name)
+}
+
+// Ctx gets the context of the request as handed over to Handle, e.g. carrying the deadline of Lambda.
+// Pass it to every call taking time, so it is cancelled when the response is due.
+func (c *Context) Ctx() context.Context {
+	if c.ctx == nil {
+		return context.Background()
 	}
+	return c.ctx
 }
 
 // Now returns the time of the request on users side
@@ -189,7 +329,8 @@ func (c *Context) Now() time.Time {
 	return time.Now()
 }
 
-// Abort prevents the execution of a following handler within an alexa.MultiHandler chain.
+// Abort prevents the execution of a following handler within an alexa.MultiHandler chain,
+// or of the following interceptors.
 func (c *Context) Abort() {
 	c.abort = true
 }
@@ -0,0 +1,68 @@
+package dialog
+
+// APL directive types
+const (
+	APLRenderDocument  = "Alexa.Presentation.APL.RenderDocument"
+	APLExecuteCommands = "Alexa.Presentation.APL.ExecuteCommands"
+)
+
+// APLUserEvent is the request type sent by the SendEvent command, e.g. on touching a component
+const APLUserEvent = "Alexa.Presentation.APL.UserEvent"
+
+// APLSource describes the component that sent a UserEvent
+type APLSource struct {
+	Type    string      `json:"type"`
+	Handler string      `json:"handler"`
+	ID      string      `json:"id"`
+	Value   interface{} `json:"value"`
+}
+
+// RenderDocumentDirective renders an APL document on the devices screen
+type RenderDocumentDirective struct {
+	Type string `json:"type"`
+	// Token identifies the document, e.g. for ExecuteCommands or UserEvent requests
+	Token string `json:"token"`
+	// Document is the APL document, e.g. a map, a struct or a json.RawMessage loaded from a file
+	Document interface{} `json:"document"`
+	// Datasources are bound to the document
+	Datasources map[string]interface{} `json:"datasources,omitempty"`
+}
+
+// ExecuteCommandsDirective runs APL commands on a rendered document
+type ExecuteCommandsDirective struct {
+	Type string `json:"type"`
+	// Token is the token of the rendered document
+	Token string `json:"token"`
+	// Commands are APL commands, e.g. maps or structs
+	Commands []interface{} `json:"commands"`
+}
+
+// LinkDocument returns a document referring to one saved in the developer console,
+// e.g. "doc://alexa/apl/documents/MyDocument"
+func LinkDocument(src string) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "Link",
+		"src":  src,
+	}
+}
+
+// RenderDocument adds an Alexa.Presentation.APL.RenderDocument directive
+func (er *EchoResponse) RenderDocument(token string, document interface{}, datasources map[string]interface{}) *EchoResponse {
+	er.Response.Directives.Add(RenderDocumentDirective{
+		Type:        APLRenderDocument,
+		Token:       token,
+		Document:    document,
+		Datasources: datasources,
+	})
+	return er
+}
+
+// ExecuteCommands adds an Alexa.Presentation.APL.ExecuteCommands directive
+func (er *EchoResponse) ExecuteCommands(token string, commands ...interface{}) *EchoResponse {
+	er.Response.Directives.Add(ExecuteCommandsDirective{
+		Type:     APLExecuteCommands,
+		Token:    token,
+		Commands: commands,
+	})
+	return er
+}
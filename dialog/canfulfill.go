@@ -0,0 +1,46 @@
+package dialog
+
+import "fmt"
+
+// CanFulfillIntentRequest is the request type asking whether the skill can handle an utterance without being invoked by name
+const CanFulfillIntentRequest = "CanFulfillIntentRequest"
+
+// answers of a CanFulfillIntent
+const (
+	CanFulfillYes   = "YES"
+	CanFulfillNo    = "NO"
+	CanFulfillMaybe = "MAYBE"
+)
+
+// CanFulfillIntent is the answer to a CanFulfillIntentRequest
+type CanFulfillIntent struct {
+	// CanFulfill is one of the CanFulfill constants
+	CanFulfill string `json:"canFulfill"`
+	// Slots are the answers for each slot of the request with a value
+	Slots map[string]CanFulfillSlot `json:"slots,omitempty"`
+}
+
+// CanFulfillSlot is the answer for one slot
+type CanFulfillSlot struct {
+	// CanUnderstand tells, whether the value of the slot is known, one of the CanFulfill constants
+	CanUnderstand string `json:"canUnderstand"`
+	// CanFulfill tells, whether the skill can act on the value, CanFulfillYes or CanFulfillNo
+	CanFulfill string `json:"canFulfill"`
+}
+
+// CanFulfill sets the answer to a CanFulfillIntentRequest.
+// The response must not contain anything else then.
+func (er *EchoResponse) CanFulfill(answer *CanFulfillIntent) *EchoResponse {
+	er.Response.CanFulfillIntent = answer
+	er.Response.ShouldEndSession = nil
+	return er
+}
+
+// validateCanFulfill rejects any speech, card or directive next to a CanFulfillIntent
+func (er *EchoResponse) validateCanFulfill() error {
+	r := &er.Response
+	if r.OutputSpeech != nil || r.Reprompt != nil || r.Card != nil || len(r.Directives) > 0 {
+		return fmt.Errorf("%w: only canFulfillIntent allowed", ErrInvalidResponse)
+	}
+	return nil
+}
@@ -1,14 +1,34 @@
 package dialog
 
-import "github.com/dasjott/alexa-sdk-go/ssml"
+import (
+	"sync"
+
+	"github.com/dasjott/alexa-sdk-go/ssml"
+)
 
 // Objects common to request and response
 
-var voice = func(s string) string { return s }
+var voiceName string
+var voiceMutex sync.RWMutex
 
-// SetVoice sets a voice to be used for all following output
+// SetVoice sets a voice to be used for all following output. An empty name resets to Alexas own voice.
+// Prefer EchoResponse.SetVoice or the Voice of an alexa.Skill, if you host more than one skill.
 func SetVoice(name string) {
-	voice = ssml.NewVoice(name)
+	voiceMutex.Lock()
+	voiceName = name
+	voiceMutex.Unlock()
+}
+
+// voice surrounds s with the voice set by SetVoice
+func voice(s string) string {
+	voiceMutex.RLock()
+	name := voiceName
+	voiceMutex.RUnlock()
+
+	if name == "" {
+		return s
+	}
+	return ssml.Voice(name, s)
 }
 
 // EchoIntent is the json part for an intent
@@ -19,12 +39,26 @@ type EchoIntent struct {
 }
 
 type EchoImage struct {
-	Sources []EchoImageSize `json:"sources"`
+	ContentDescription string          `json:"contentDescription,omitempty"`
+	Sources            []EchoImageSize `json:"sources"`
 }
 
 type EchoImageSize struct {
 	URL          string `json:"url"`
-	Size         string `json:"size"`
-	WidthPixels  int    `json:"widthPixels"`
-	HeightPixels int    `json:"heightPixels"`
+	Size         string `json:"size,omitempty"`
+	WidthPixels  int    `json:"widthPixels,omitempty"`
+	HeightPixels int    `json:"heightPixels,omitempty"`
+}
+
+// empty determines whether the image is not set at all
+func (img *EchoImage) empty() bool {
+	return img.ContentDescription == "" && len(img.Sources) == 0
+}
+
+// NewImage creates an image with a single source of unknown size
+func NewImage(url, description string) *EchoImage {
+	return &EchoImage{
+		ContentDescription: description,
+		Sources:            []EchoImageSize{{URL: url}},
+	}
 }
@@ -0,0 +1,82 @@
+package dialog
+
+// names of the supported interfaces of a device
+const (
+	InterfaceAudioPlayer = "AudioPlayer"
+	InterfaceDisplay     = "Display"
+	InterfaceVideoApp    = "VideoApp"
+	InterfaceAPL         = "Alexa.Presentation.APL"
+	InterfaceAPLT        = "Alexa.Presentation.APLT"
+)
+
+// viewport shapes
+const (
+	ShapeRectangle = "RECTANGLE"
+	ShapeRound     = "ROUND"
+)
+
+// viewport modes
+const (
+	ModeHub    = "HUB"
+	ModeTV     = "TV"
+	ModeMobile = "MOBILE"
+	ModePC     = "PC"
+	ModeAuto   = "AUTO"
+)
+
+// EchoViewport describes the screen of the device
+type EchoViewport struct {
+	Experiences        []EchoViewportExperience `json:"experiences"`
+	Mode               string                   `json:"mode"`
+	Shape              string                   `json:"shape"`
+	PixelWidth         int                      `json:"pixelWidth"`
+	PixelHeight        int                      `json:"pixelHeight"`
+	CurrentPixelWidth  int                      `json:"currentPixelWidth"`
+	CurrentPixelHeight int                      `json:"currentPixelHeight"`
+	DPI                int                      `json:"dpi"`
+	Touch              []string                 `json:"touch"`
+	Keyboard           []string                 `json:"keyboard"`
+	Video              *struct {
+		Codecs []string `json:"codecs"`
+	} `json:"video"`
+}
+
+type EchoViewportExperience struct {
+	ArcMinuteWidth  int  `json:"arcMinuteWidth"`
+	ArcMinuteHeight int  `json:"arcMinuteHeight"`
+	CanRotate       bool `json:"canRotate"`
+	CanResize       bool `json:"canResize"`
+}
+
+// EchoViewportItem is one of the viewports of a device, each supporting a certain type of documents
+type EchoViewportItem struct {
+	Type             string `json:"type"`
+	ID               string `json:"id"`
+	Shape            string `json:"shape"`
+	DPI              int    `json:"dpi"`
+	PresentationType string `json:"presentationType"`
+	CanRotate        bool   `json:"canRotate"`
+	Configuration    struct {
+		Current struct {
+			Mode string `json:"mode"`
+			Size struct {
+				Type        string `json:"type"`
+				PixelWidth  int    `json:"pixelWidth"`
+				PixelHeight int    `json:"pixelHeight"`
+			} `json:"size"`
+		} `json:"current"`
+	} `json:"configuration"`
+}
+
+// EchoAPLContext is the state of the APL document currently displayed
+type EchoAPLContext struct {
+	Token                     string        `json:"token"`
+	Version                   string        `json:"version"`
+	ComponentsVisibleOnScreen []interface{} `json:"componentsVisibleOnScreen"`
+}
+
+// Supports determines whether the device supports the given interface, e.g. InterfaceAPL
+func (d *EchoDevice) Supports(name string) bool {
+	_, exists := d.SupportedInterfaces[name]
+	return exists
+}
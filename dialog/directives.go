@@ -1,5 +1,7 @@
 package dialog
 
+import "encoding/json"
+
 type EchoDirectives []interface{}
 
 func (d *EchoDirectives) Add(directive interface{}) {
@@ -16,13 +18,86 @@ type SlotDirective struct {
 	UpdatedIntent *EchoIntent `json:"updatedIntent,omitempty"`
 }
 
+// DYNAMIC ENTITIES
+
+// behaviors for Dialog.UpdateDynamicEntities
+const (
+	// UpdateBehaviorReplace replaces all dynamic entities
+	UpdateBehaviorReplace = "REPLACE"
+	// UpdateBehaviorClear removes all dynamic entities
+	UpdateBehaviorClear = "CLEAR"
+)
+
+// DynamicEntitiesDirective is the directive to update the values of slot types for the current session
+type DynamicEntitiesDirective struct {
+	Type           string           `json:"type"`
+	UpdateBehavior string           `json:"updateBehavior"`
+	Types          []EntityListItem `json:"types,omitempty"`
+}
+
+// EntityListItem holds the values of one slot type
+type EntityListItem struct {
+	Name   string   `json:"name"`
+	Values []Entity `json:"values"`
+}
+
+// Entity is a value of a slot type
+type Entity struct {
+	ID   string     `json:"id,omitempty"`
+	Name EntityName `json:"name"`
+}
+
+type EntityName struct {
+	Value    string   `json:"value"`
+	Synonyms []string `json:"synonyms,omitempty"`
+}
+
+// NewEntity creates a slot type value with its synonyms
+func NewEntity(id, value string, synonyms ...string) Entity {
+	return Entity{
+		ID: id,
+		Name: EntityName{
+			Value:    value,
+			Synonyms: synonyms,
+		},
+	}
+}
+
 // AUDIO PLAYER
 
+// behaviors for AudioPlayer.Play and AudioPlayer.ClearQueue
+const (
+	// PlayBehaviorReplaceAll stops the current stream and replaces the queue
+	PlayBehaviorReplaceAll = "REPLACE_ALL"
+	// PlayBehaviorEnqueue adds the stream to the end of the queue
+	PlayBehaviorEnqueue = "ENQUEUE"
+	// PlayBehaviorReplaceEnqueued replaces the queue, but keeps the current stream playing
+	PlayBehaviorReplaceEnqueued = "REPLACE_ENQUEUED"
+	// ClearBehaviorClearEnqueued clears the queue, but keeps the current stream playing
+	ClearBehaviorClearEnqueued = "CLEAR_ENQUEUED"
+	// ClearBehaviorClearAll clears the queue and stops the current stream
+	ClearBehaviorClearAll = "CLEAR_ALL"
+)
+
+// AudioDirective is the directive for AudioPlayer.Play, AudioPlayer.Stop and AudioPlayer.ClearQueue
 type AudioDirective struct {
 	Type          string        `json:"type"`
-	PlayBehaviour string        `json:"playBehavior",omitempty`  // either play or clear
-	ClearBehavior string        `json:"clearBehavior",omitempty` // either play or clear
-	Audioitem     EchoAudioItem `json:"audioItem"`
+	PlayBehaviour string        `json:"playBehavior,omitempty"`  // only for AudioPlayer.Play
+	ClearBehavior string        `json:"clearBehavior,omitempty"` // only for AudioPlayer.ClearQueue
+	Audioitem     EchoAudioItem `json:"audioItem"`               // only for AudioPlayer.Play
+}
+
+// MarshalJSON leaves out the audio item, if it is not set, as for AudioPlayer.Stop and AudioPlayer.ClearQueue
+func (d AudioDirective) MarshalJSON() ([]byte, error) {
+	type directive AudioDirective
+	v := struct {
+		directive
+		Audioitem *EchoAudioItem `json:"audioItem,omitempty"`
+	}{directive: directive(d)}
+	if d.Audioitem != (EchoAudioItem{}) {
+		v.Audioitem = &d.Audioitem
+	}
+	return json.Marshal(v)
 }
 
 type EchoAudioItem struct {
@@ -30,16 +105,35 @@ type EchoAudioItem struct {
 	Meta   *AudioMetadata `json:"metadata,omitempty"`
 }
 
+// AudioStream describes the stream to be played
 type AudioStream struct {
 	Url                   string `json:"url"`
 	Token                 string `json:"token"`
-	ExpectedPreviousToken string `json:"expectedPreviousToken,omitempty"`
+	ExpectedPreviousToken string `json:"expectedPreviousToken,omitempty"` // only for ENQUEUE
 	OffsetInMilliseconds  int    `json:"offsetInMilliseconds"`
 }
 
+// AudioMetadata is displayed on devices with a screen
 type AudioMetadata struct {
-	Title           string    `json:"title"`
-	Subtitle        string    `json:"subtitle"`
+	Title           string    `json:"title,omitempty"`
+	Subtitle        string    `json:"subtitle,omitempty"`
 	Art             EchoImage `json:"art"`
 	BackgroundImage EchoImage `json:"backgroundImage"`
 }
+
+// MarshalJSON leaves out the images, which are not set
+func (m AudioMetadata) MarshalJSON() ([]byte, error) {
+	type metadata AudioMetadata
+	v := struct {
+		metadata
+		Art             *EchoImage `json:"art,omitempty"`
+		BackgroundImage *EchoImage `json:"backgroundImage,omitempty"`
+	}{metadata: metadata(m)}
+	if !m.Art.empty() {
+		v.Art = &m.Art
+	}
+	if !m.BackgroundImage.empty() {
+		v.BackgroundImage = &m.BackgroundImage
+	}
+	return json.Marshal(v)
+}
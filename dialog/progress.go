@@ -2,10 +2,13 @@ package dialog
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
+	"github.com/dasjott/alexa-sdk-go/ssml"
 	"github.com/dasjott/alexa-sdk-go/test"
 )
 
@@ -23,6 +26,9 @@ type ProgressRequest struct {
 
 	// for internal use, not for json
 	system *EchoSystem
+	client *http.Client
+	ctx    context.Context
+	speech string
 	wait   chan int
 }
 
@@ -34,28 +40,53 @@ func NewProgressRequest(speech, requestID string, sys *EchoSystem) *ProgressRequ
 		p.Directive.Speech = "<speak>" + voice(speech) + "</speak>"
 		p.Directive.Type = "VoicePlayer.Speak"
 		p.system = sys
+		p.speech = speech
 		return &p
 	}
 	return nil
 }
 
+// SetVoice sets the voice to be used for this progress
+func (p *ProgressRequest) SetVoice(name string) *ProgressRequest {
+	p.Directive.Speech = "<speak>" + ssml.Voice(name, p.speech) + "</speak>"
+	return p
+}
+
+// WithHTTPClient sets the client to send the request with. If nil, http.DefaultClient is used.
+func (p *ProgressRequest) WithHTTPClient(client *http.Client) *ProgressRequest {
+	p.client = client
+	return p
+}
+
+// WithContext sets the context of the request. If it is done, the request is cancelled.
+func (p *ProgressRequest) WithContext(ctx context.Context) *ProgressRequest {
+	p.ctx = ctx
+	return p
+}
+
 // Send actually sends the request to where it belongs
 func (p *ProgressRequest) Send() {
 	data, _ := json.Marshal(p)
-	req, err := http.NewRequest(http.MethodPost, p.system.APIEndpoint+endpoint, bytes.NewReader(data))
-	req.Header.Add("Authorization", "Bearer "+p.system.APIAccessToken)
-	req.Header.Add("Content-Type", "application/json")
+	ctx := p.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.system.APIEndpoint+endpoint, bytes.NewReader(data))
 
 	if err == nil {
-		p.wait = make(chan int)
+		req.Header.Add("Authorization", "Bearer "+p.system.APIAccessToken)
+		req.Header.Add("Content-Type", "application/json")
+		// buffered, so the request finishes even if nobody waits for it
+		p.wait = make(chan int, 1)
 		go func() {
 			var resp *http.Response
 			var err error
-			if test.RequestHandler != nil {
+			if p.client != nil {
+				resp, err = p.client.Do(req)
+			} else if test.RequestHandler != nil {
 				resp = test.RequestHandler(req)
 			} else {
-				client := http.Client{}
-				resp, err = client.Do(req)
+				resp, err = http.DefaultClient.Do(req)
 			}
 			if err != nil {
 				fmt.Println("progress error: ", err.Error())
@@ -63,6 +94,11 @@ func (p *ProgressRequest) Send() {
 			code := 0
 			if resp != nil {
 				code = resp.StatusCode
+				if resp.Body != nil {
+					// drain the body, so the connection can be reused
+					io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+				}
 			}
 			p.wait <- code
 		}()
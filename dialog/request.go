@@ -20,14 +20,97 @@ type EchoSession struct {
 	User        EchoUser               `json:"user"`
 }
 
+// status codes of entity resolution
+const (
+	ERSuccessMatch   = "ER_SUCCESS_MATCH"
+	ERSuccessNoMatch = "ER_SUCCESS_NO_MATCH"
+	ERErrorTimeout   = "ER_ERROR_TIMEOUT"
+	ERErrorException = "ER_ERROR_EXCEPTION"
+)
+
+// AudioPlayer request types
+const (
+	PlaybackStarted        = "AudioPlayer.PlaybackStarted"
+	PlaybackFinished       = "AudioPlayer.PlaybackFinished"
+	PlaybackStopped        = "AudioPlayer.PlaybackStopped"
+	PlaybackNearlyFinished = "AudioPlayer.PlaybackNearlyFinished"
+	PlaybackFailed         = "AudioPlayer.PlaybackFailed"
+)
+
+// PlaybackController request types, sent on pressing buttons of a device or remote
+const (
+	PlaybackControllerNext     = "PlaybackController.NextCommandIssued"
+	PlaybackControllerPrevious = "PlaybackController.PreviousCommandIssued"
+	PlaybackControllerPlay     = "PlaybackController.PlayCommandIssued"
+	PlaybackControllerPause    = "PlaybackController.PauseCommandIssued"
+)
+
+// player activities of the AudioPlayer
+const (
+	PlayerActivityIdle           = "IDLE"
+	PlayerActivityPaused         = "PAUSED"
+	PlayerActivityPlaying        = "PLAYING"
+	PlayerActivityBufferUnderrun = "BUFFER_UNDERRUN"
+	PlayerActivityFinished       = "FINISHED"
+	PlayerActivityStopped        = "STOPPED"
+)
+
+// SessionEndedRequest is the request type sent when the session ended
+const SessionEndedRequest = "SessionEndedRequest"
+
+// reasons of a SessionEndedRequest
+const (
+	ReasonUserInitiated        = "USER_INITIATED"
+	ReasonError                = "ERROR"
+	ReasonExceededMaxReprompts = "EXCEEDED_MAX_REPROMPTS"
+)
+
+// types of an EchoError
+const (
+	ErrorInvalidResponse         = "INVALID_RESPONSE"
+	ErrorDeviceCommunication     = "DEVICE_COMMUNICATION_ERROR"
+	ErrorInternalService         = "INTERNAL_SERVICE_ERROR"
+	ErrorInternalDevice          = "INTERNAL_DEVICE_ERROR"
+	ErrorEndpointTimeout         = "ENDPOINT_TIMEOUT"
+	ErrorMediaUnknown            = "MEDIA_ERROR_UNKNOWN"
+	ErrorMediaInvalidRequest     = "MEDIA_ERROR_INVALID_REQUEST"
+	ErrorMediaServiceUnavailable = "MEDIA_ERROR_SERVICE_UNAVAILABLE"
+	ErrorMediaInternalServer     = "MEDIA_ERROR_INTERNAL_SERVER_ERROR"
+	ErrorMediaInternalDevice     = "MEDIA_ERROR_INTERNAL_DEVICE_ERROR"
+)
+
 type EchoRequestBody struct {
 	Type        string     `json:"type"`
 	RequestID   string     `json:"requestId"`
 	Timestamp   string     `json:"timestamp"`
 	DialogState string     `json:"dialogState"`
 	Intent      EchoIntent `json:"intent"`
-	Reason      string     `json:"reason"`
+	Reason      string     `json:"reason"` // one of the Reason constants for SessionEndedRequest
 	Locale      string     `json:"locale"`
+
+	// AudioPlayer requests, the error is also set for SessionEndedRequest
+	Token                string           `json:"token"`
+	OffsetInMilliseconds int              `json:"offsetInMilliseconds"`
+	Error                *EchoError       `json:"error"`
+	CurrentPlaybackState *EchoAudioPlayer `json:"currentPlaybackState"`
+
+	// Alexa.Presentation.APL.UserEvent requests, the token is the one of the document
+	Arguments  []interface{}          `json:"arguments"`
+	Source     *APLSource             `json:"source"`
+	Components map[string]interface{} `json:"components"`
+}
+
+// EchoError describes an error reported by Alexa
+type EchoError struct {
+	Type    string `json:"type"` // one of the Error constants
+	Message string `json:"message"`
+}
+
+// EchoAudioPlayer is the state of the AudioPlayer
+type EchoAudioPlayer struct {
+	Token                string `json:"token"`
+	OffsetInMilliseconds int    `json:"offsetInMilliseconds"`
+	PlayerActivity       string `json:"playerActivity"`
 }
 
 type EchoApplication struct {
@@ -60,24 +143,45 @@ type EchoSystem struct {
 }
 
 type EchoRequestContext struct {
-	System EchoSystem `json:"System"`
+	System      EchoSystem         `json:"System"`
+	AudioPlayer *EchoAudioPlayer   `json:"AudioPlayer"`
+	Viewport    *EchoViewport      `json:"Viewport"`
+	Viewports   []EchoViewportItem `json:"Viewports"`
+	APL         *EchoAPLContext    `json:"Alexa.Presentation.APL"`
 }
 
+// types of slot values
+const (
+	SlotValueSimple = "Simple"
+	SlotValueList   = "List"
+)
+
 // EchoSlot is the json part for a slot
 type EchoSlot struct {
-	Name               string `json:"name"`
-	Value              string `json:"value"`
-	ConfirmationStatus string `json:"confirmationStatus,omitempty"`
-	Resolutions        *struct {
-		ResolutionsPerAuthority []EchoAuthorityResolution `json:"resolutionsPerAuthority"`
-	} `json:"resolutions"`
+	Name               string           `json:"name"`
+	Value              string           `json:"value"`
+	ConfirmationStatus string           `json:"confirmationStatus,omitempty"`
+	Resolutions        *EchoResolutions `json:"resolutions"`
+	SlotValue          *EchoSlotValue   `json:"slotValue,omitempty"`
+}
+
+// EchoSlotValue is either a single value or, for multi value slots, a list of values
+type EchoSlotValue struct {
+	Type        string           `json:"type"`
+	Value       string           `json:"value,omitempty"`       // only for Simple
+	Resolutions *EchoResolutions `json:"resolutions,omitempty"` // only for Simple
+	Values      []EchoSlotValue  `json:"values,omitempty"`      // only for List
+}
+
+type EchoResolutions struct {
+	ResolutionsPerAuthority []EchoAuthorityResolution `json:"resolutionsPerAuthority"`
 }
 
 type EchoAuthorityResolution struct {
 	Authority string `json:"authority"`
 	Status    struct {
 		Code string `json:"code"`
-	} `json:"code"`
+	} `json:"status"`
 	Values []EchoAuthorityResolutionValue `json:"values"`
 }
 
@@ -92,7 +196,13 @@ type NameID struct {
 
 // FUNCTIONS //////////////////////////////////////////////////////////////////
 func (er *EchoRequest) VerifyTimestamp() bool {
-	return time.Since(er.GetTime()) < time.Duration(150)*time.Second
+	return er.VerifyTimestampTolerance(time.Duration(150) * time.Second)
+}
+
+// VerifyTimestampTolerance checks whether the request time is within the given tolerance of the current time
+func (er *EchoRequest) VerifyTimestampTolerance(tolerance time.Duration) bool {
+	diff := time.Since(er.GetTime())
+	return diff < tolerance && diff > -tolerance
 }
 
 func (er *EchoRequest) VerifyAppID(appID string) bool {
@@ -118,6 +228,22 @@ func (er *EchoRequest) GetIntentName() string {
 	return er.GetRequestType()
 }
 
+// IsAudioPlayer determines whether this is one of the AudioPlayer requests
+func (er *EchoRequest) IsAudioPlayer() bool {
+	return strings.HasPrefix(er.GetRequestType(), "AudioPlayer.")
+}
+
+// IsPlaybackController determines whether this is one of the PlaybackController requests
+func (er *EchoRequest) IsPlaybackController() bool {
+	return strings.HasPrefix(er.GetRequestType(), "PlaybackController.")
+}
+
+// HasSession determines whether the request belongs to a session.
+// AudioPlayer and PlaybackController requests are sent without.
+func (er *EchoRequest) HasSession() bool {
+	return er.Session.SessionID != ""
+}
+
 func (er *EchoRequest) GetTime() time.Time {
 	t, _ := time.Parse("2006-01-02T15:04:05Z", er.Request.Timestamp)
 	return t
@@ -128,7 +254,12 @@ func (res *EchoAuthorityResolution) IsBuiltIn() bool {
 	return len(parts) > 4 && parts[4] == "AMAZON"
 }
 
+// IsDynamic determines whether the resolution is one of the dynamic entities
+func (res *EchoAuthorityResolution) IsDynamic() bool {
+	return strings.Contains(res.Authority, ".echo-sdk.dynamic.")
+}
+
 func (res *EchoAuthorityResolution) IsMatch() bool {
-	return res.Status.Code == "ER_SUCCESS_MATCH"
+	return res.Status.Code == ERSuccessMatch
 	// return (res.Status.Code == "ER_SUCCESS_MATCH") || (res.IsBuiltIn() && res.Status.Code == "ER_SUCCESS_NO_MATCH")
 }
@@ -1,19 +1,34 @@
 package dialog
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/dasjott/alexa-sdk-go/ssml"
+)
 
 type EchoResponse struct {
 	Version           string                 `json:"version"`
-	SessionAttributes map[string]interface{} `json:"sessionAttributes"`
+	SessionAttributes map[string]interface{} `json:"sessionAttributes,omitempty"`
 	Response          EchoResponseBody       `json:"response"`
+
+	// for internal use, not for json
+	voice     func(string) string
+	audioOnly bool
 }
 
+// ErrInvalidResponse is returned by Validate, if the response contains parts not allowed
+var ErrInvalidResponse = errors.New("invalid response")
+
 type EchoResponseBody struct {
 	OutputSpeech     *EchoOutput    `json:"outputSpeech,omitempty"`
 	Card             *EchoCard      `json:"card,omitempty"`
-	Reprompt         *EchoReprompt  `json:"reprompt,omitempty"` // Pointer so it's dropped if empty in JSON response.
-	ShouldEndSession bool           `json:"shouldEndSession"`
+	Reprompt         *EchoReprompt  `json:"reprompt,omitempty"`         // Pointer so it's dropped if empty in JSON response.
+	ShouldEndSession *bool          `json:"shouldEndSession,omitempty"` // Pointer, as it must be omitted for some requests.
 	Directives       EchoDirectives `json:"directives,omitempty"`
+
+	CanFulfillIntent *CanFulfillIntent `json:"canFulfillIntent,omitempty"`
 }
 
 type EchoReprompt struct {
@@ -46,13 +61,31 @@ func NewResponse() *EchoResponse {
 	er := &EchoResponse{
 		Version: "1.0",
 		Response: EchoResponseBody{
-			ShouldEndSession: false,
+			ShouldEndSession: boolPtr(false),
 		},
 		SessionAttributes: make(map[string]interface{}),
 	}
 	return er
 }
 
+// SetVoice sets a voice to be used for all following output of this response.
+// Without or with an empty name, the voice set by the package function SetVoice is used.
+func (er *EchoResponse) SetVoice(name string) *EchoResponse {
+	if name == "" {
+		er.voice = nil
+	} else {
+		er.voice = ssml.NewVoice(name)
+	}
+	return er
+}
+
+func (er *EchoResponse) speak(text string) string {
+	if er.voice == nil {
+		return "<speak>" + voice(text) + "</speak>"
+	}
+	return "<speak>" + er.voice(text) + "</speak>"
+}
+
 func (er *EchoResponse) OutputText(text string) *EchoResponse {
 	er.Response.OutputSpeech = &EchoOutput{
 		Type: "PlainText",
@@ -64,7 +97,7 @@ func (er *EchoResponse) OutputText(text string) *EchoResponse {
 func (er *EchoResponse) OutputSSML(text string) *EchoResponse {
 	er.Response.OutputSpeech = &EchoOutput{
 		Type: "SSML",
-		SSML: "<speak>" + voice(text) + "</speak>",
+		SSML: er.speak(text),
 	}
 	return er
 }
@@ -79,23 +112,43 @@ func (er *EchoResponse) SlotDirective(directiveType, slotToElicit, slotToConfirm
 	return er
 }
 
-// AudioDirective is for AudioPlayer.Play, AudioPlayer.Stop, AudioPlayer.ClearQueue
-func (er *EchoResponse) AudioDirective(directiveType, behaviour, url, id string) *EchoResponse {
-	// dir := AudioDirective{
-	// 	Type: directiveType,
-	// }
-	// if AudioPlayer.ClearQueue {
-	// 	dir.ClearBehavior = behavior
-	// } else {
-	// 	PlayBehaviour = behaviour
-	// }
-	// if url != "" {
-	// 	dir.Audioitem.Stream.Url = url
-	// 	dir.Audioitem.Stream.Token = id
-	// 	dir.Audioitem.Stream.
-	// }
+// UpdateDynamicEntities adds a Dialog.UpdateDynamicEntities directive. Use one of the UpdateBehavior constants.
+func (er *EchoResponse) UpdateDynamicEntities(behavior string, types ...EntityListItem) *EchoResponse {
+	er.Response.Directives.Add(DynamicEntitiesDirective{
+		Type:           "Dialog.UpdateDynamicEntities",
+		UpdateBehavior: behavior,
+		Types:          types,
+	})
+	return er
+}
+
+// AudioPlay adds an AudioPlayer.Play directive. Use one of the PlayBehavior constants.
+func (er *EchoResponse) AudioPlay(behavior string, stream AudioStream, meta *AudioMetadata) *EchoResponse {
+	er.Response.Directives.Add(AudioDirective{
+		Type:          "AudioPlayer.Play",
+		PlayBehaviour: behavior,
+		Audioitem: EchoAudioItem{
+			Stream: stream,
+			Meta:   meta,
+		},
+	})
+	return er
+}
+
+// AudioStop adds an AudioPlayer.Stop directive
+func (er *EchoResponse) AudioStop() *EchoResponse {
+	er.Response.Directives.Add(AudioDirective{
+		Type: "AudioPlayer.Stop",
+	})
+	return er
+}
 
-	// er.Response.Directives.Add(&dir)
+// AudioClearQueue adds an AudioPlayer.ClearQueue directive. Use one of the ClearBehavior constants.
+func (er *EchoResponse) AudioClearQueue(behavior string) *EchoResponse {
+	er.Response.Directives.Add(AudioDirective{
+		Type:          "AudioPlayer.ClearQueue",
+		ClearBehavior: behavior,
+	})
 	return er
 }
 
@@ -159,17 +212,58 @@ func (er *EchoResponse) RepromptSSML(text string) *EchoResponse {
 		er.Response.Reprompt = &EchoReprompt{
 			OutputSpeech: EchoOutput{
 				Type: "SSML",
-				SSML: "<speak>" + voice(text) + "</speak>",
+				SSML: er.speak(text),
 			},
 		}
 	}
 	return er
 }
 func (er *EchoResponse) EndSession() *EchoResponse {
-	er.Response.ShouldEndSession = true
+	er.Response.ShouldEndSession = boolPtr(true)
+	return er
+}
+
+// NoSession omits shouldEndSession and the session attributes.
+// Responses to requests outside of a session, like AudioPlayer requests, must not contain them.
+func (er *EchoResponse) NoSession() *EchoResponse {
+	er.Response.ShouldEndSession = nil
+	er.SessionAttributes = nil
 	return er
 }
 
+// AudioOnly restricts the response to AudioPlayer directives, as required for AudioPlayer and PlaybackController requests.
+// Validate then rejects any speech, reprompt, card or other directive.
+func (er *EchoResponse) AudioOnly() *EchoResponse {
+	er.audioOnly = true
+	return er.NoSession()
+}
+
+// Validate checks the response for parts not allowed
+func (er *EchoResponse) Validate() error {
+	if er.Response.CanFulfillIntent != nil {
+		return er.validateCanFulfill()
+	}
+	if !er.audioOnly {
+		return nil
+	}
+	if er.Response.OutputSpeech != nil || er.Response.Reprompt != nil {
+		return fmt.Errorf("%w: speech not allowed", ErrInvalidResponse)
+	}
+	if er.Response.Card != nil {
+		return fmt.Errorf("%w: card not allowed", ErrInvalidResponse)
+	}
+	for _, d := range er.Response.Directives {
+		if _, ok := d.(AudioDirective); !ok {
+			return fmt.Errorf("%w: only AudioPlayer directives allowed", ErrInvalidResponse)
+		}
+	}
+	return nil
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
 func (er *EchoResponse) Data() []byte {
 	jsonStr, err := json.Marshal(&er)
 	if err != nil {
@@ -0,0 +1,59 @@
+package alexa
+
+import "errors"
+
+var (
+	// ErrNilRequest is returned if Handle is called without a request
+	ErrNilRequest = errors.New("echo request is nil")
+	// ErrInvalidTimestamp is returned if the request is older than TimestampTolerance
+	ErrInvalidTimestamp = errors.New("invalid timestamp")
+	// ErrInvalidAppID is returned if the request is not meant for AppID
+	ErrInvalidAppID = errors.New("invalid app id")
+	// ErrNoHandlers is returned if neither Handlers nor Routes are set
+	ErrNoHandlers = errors.New("no handlers set")
+	// ErrNoTimeoutKey is returned if a TimeBudget is set without a TimeoutKey, which would leave the user in silence
+	ErrNoTimeoutKey = errors.New("time budget without timeout key")
+	// ErrNoHandler is returned if neither a handler for the request nor an "Unhandled" handler exists
+	ErrNoHandler = errors.New("no handler found")
+	// ErrUnsupportedLocale is returned if there are no translations for the requests locale
+	ErrUnsupportedLocale = errors.New("unsupported locale")
+
+	// ErrEmptySlot is returned on parsing the value of a slot without a value
+	ErrEmptySlot = errors.New("slot is empty")
+	// ErrUnknownValue is returned on parsing the value "?", which Alexa sends for values not understood
+	ErrUnknownValue = errors.New("slot value not understood")
+	// ErrInvalidValue is returned on parsing a slot value of an unexpected format
+	ErrInvalidValue = errors.New("invalid slot value")
+)
+
+// HandlerError is returned if there is no handler for an intent or request type.
+// It matches ErrNoHandler with errors.Is.
+type HandlerError struct {
+	// Intent is the name of the intent or the request type
+	Intent string
+}
+
+func (e *HandlerError) Error() string {
+	return "no handler found for " + e.Intent
+}
+
+// Unwrap returns ErrNoHandler
+func (e *HandlerError) Unwrap() error {
+	return ErrNoHandler
+}
+
+// LocaleError is returned if there are no translations for a locale.
+// It matches ErrUnsupportedLocale with errors.Is.
+type LocaleError struct {
+	// Locale is the locale of the request
+	Locale string
+}
+
+func (e *LocaleError) Error() string {
+	return "language " + e.Locale + " not implemented"
+}
+
+// Unwrap returns ErrUnsupportedLocale
+func (e *LocaleError) Unwrap() error {
+	return ErrUnsupportedLocale
+}
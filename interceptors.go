@@ -0,0 +1,44 @@
+package alexa
+
+import "github.com/dasjott/alexa-sdk-go/dialog"
+
+// Interceptor is called for every request of any type, before or after the handler.
+// Call c.Abort() to skip the following interceptors. Aborting a request interceptor skips the handler as well.
+type Interceptor func(c *Context)
+
+// Request gets the request as sent by Alexa
+func (c *Context) Request() *dialog.EchoRequest {
+	return c.request
+}
+
+// Response gets the response built so far
+func (c *Context) Response() *dialog.EchoResponse {
+	return c.response
+}
+
+// SetResponse replaces the response built so far
+func (c *Context) SetResponse(response *dialog.EchoResponse) {
+	c.response = response
+}
+
+// interceptRequest runs the request interceptors and determines whether to go on with the handler
+func (c *Context) interceptRequest() bool {
+	for _, intercept := range c.skill.RequestInterceptors {
+		if c.abort {
+			break
+		}
+		intercept(c)
+	}
+	return !c.abort
+}
+
+// interceptResponse runs the response interceptors, even if the request was aborted
+func (c *Context) interceptResponse() {
+	c.abort = false
+	for _, intercept := range c.skill.ResponseInterceptors {
+		if c.abort {
+			break
+		}
+		intercept(c)
+	}
+}
@@ -1,9 +1,11 @@
 package alexa
 
 import (
+	"math/rand"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/text/language"
 	"golang.org/x/text/message"
@@ -21,6 +23,7 @@ type Translator struct {
 	// Format   func(string, ...interface{}) string
 	printer  *message.Printer // formatter for the current language
 	decimals int              // number of decimal places
+	random   *rand.Rand       // random source, owned by this translator
 }
 
 // R (for Replace) is a shortcut for map[string]interface{}, while the value must be int (any), float (any) or string
@@ -38,6 +41,7 @@ func (loc Localisation) GetTranslator(locale string) *Translator {
 				Phrases:  ph,
 				printer:  message.NewPrinter(lang),
 				decimals: 2,
+				random:   rand.New(rand.NewSource(time.Now().UnixNano())),
 			}
 			if dec, ok := ph[":decimals"].(int); ok {
 				trans.decimals = dec
@@ -70,13 +74,22 @@ func (tr *Translator) GetString(key string) string {
 		case []string:
 			arr := val.([]string)
 			if count := len(arr); count > 0 {
-				return arr[random.Intn(len(arr))]
+				return arr[tr.intn(count)]
 			}
 		}
 	}
 	return ""
 }
 
+// intn returns a random number in [0,n) from the translators own source.
+// A Translator is meant to be used by one request at a time, just like the Context.
+func (tr *Translator) intn(n int) int {
+	if tr.random == nil {
+		return rand.Intn(n)
+	}
+	return tr.random.Intn(n)
+}
+
 // GetArray gets an array from the value according to the given key
 func (tr *Translator) GetArray(key string) []string {
 	if val, exists := tr.Phrases[key]; exists {
@@ -0,0 +1,75 @@
+package alexa
+
+import "errors"
+
+// ErrNoPersistence is returned if persistent attributes are used without a PersistenceAdapter
+var ErrNoPersistence = errors.New("no persistence adapter set")
+
+// PersistenceAdapter loads and saves persistent attributes, e.g. from a file or a database.
+// See the persistence package for implementations.
+type PersistenceAdapter interface {
+	// Load returns the attributes stored for key. If there are none, it returns an empty map and no error.
+	Load(key string) (map[string]interface{}, error)
+	// Save stores the attributes for key
+	Save(key string, attributes map[string]interface{}) error
+}
+
+// UserKey is the default persistence key. It returns the users ID.
+func UserKey(c *Context) string {
+	return c.System.User.ID
+}
+
+// PersonKey returns the ID of the recognized person, if any, and the users ID otherwise.
+// Use it as Skill.PersistenceKey to store attributes for every person sharing a device.
+func PersonKey(c *Context) string {
+	if c.System.Person.ID != "" {
+		return c.System.Person.ID
+	}
+	return c.System.User.ID
+}
+
+// PersistentAttr gets or sets persistent attributes, which survive the session.
+// They are loaded on first use and saved after the handler, if they were changed.
+// Works exactly like Attr for session attributes.
+func (c *Context) PersistentAttr(key string, values ...interface{}) *Attr {
+	c.loadPersistent()
+	if len(values) > 0 {
+		c.persistentChanged = true
+	}
+	return c.persistent.Attr(key, values...)
+}
+
+func (c *Context) persistenceKey() string {
+	if c.skill.PersistenceKey != nil {
+		return c.skill.PersistenceKey(c)
+	}
+	return UserKey(c)
+}
+
+func (c *Context) loadPersistent() {
+	if c.persistent != nil {
+		return
+	}
+	c.persistent = make(attributes)
+
+	if c.skill.Persistence == nil {
+		c.err = ErrNoPersistence
+		return
+	}
+	attrs, err := c.skill.Persistence.Load(c.persistenceKey())
+	if err != nil {
+		c.err = err
+		return
+	}
+	for k, v := range attrs {
+		c.persistent[k] = v
+	}
+}
+
+func (c *Context) savePersistent() error {
+	if !c.persistentChanged || c.skill.Persistence == nil {
+		return nil
+	}
+	c.persistentChanged = false
+	return c.skill.Persistence.Save(c.persistenceKey(), c.persistent)
+}
@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"encoding/json"
+)
+
+// DynamoDBAPI is the part of a DynamoDB client used by the DynamoDB adapter.
+// Implement it by wrapping GetItem and PutItem of the aws sdk, using string attributes only.
+// For tests, a map based stand-in is enough.
+type DynamoDBAPI interface {
+	// GetItem returns the item found by key or nil, if there is none
+	GetItem(table string, key map[string]string) (map[string]string, error)
+	// PutItem creates or replaces an item
+	PutItem(table string, item map[string]string) error
+}
+
+// DynamoDB stores attributes as json within a DynamoDB table
+type DynamoDB struct {
+	api   DynamoDBAPI
+	table string
+	// KeyName is the name of the tables partition key, "id" by default
+	KeyName string
+	// AttributesName is the name of the column holding the attributes, "attributes" by default
+	AttributesName string
+}
+
+// NewDynamoDB creates a DynamoDB adapter using the given client and table
+func NewDynamoDB(api DynamoDBAPI, table string) *DynamoDB {
+	return &DynamoDB{
+		api:            api,
+		table:          table,
+		KeyName:        "id",
+		AttributesName: "attributes",
+	}
+}
+
+// Load returns the attributes stored for key
+func (d *DynamoDB) Load(key string) (map[string]interface{}, error) {
+	item, err := d.api.GetItem(d.table, map[string]string{d.KeyName: key})
+	if err != nil {
+		return nil, err
+	}
+	return decode([]byte(item[d.AttributesName]))
+}
+
+// Save stores the attributes for key
+func (d *DynamoDB) Save(key string, attributes map[string]interface{}) error {
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return err
+	}
+	return d.api.PutItem(d.table, map[string]string{
+		d.KeyName:        key,
+		d.AttributesName: string(data),
+	})
+}
@@ -0,0 +1,71 @@
+package persistence
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// File stores the attributes of every key as json file within a directory
+type File struct {
+	dir   string
+	mutex sync.Mutex
+}
+
+// NewFile creates a File adapter storing into dir. The directory is created, if it does not exist.
+func NewFile(dir string) (*File, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &File{dir: dir}, nil
+}
+
+func (f *File) path(key string) string {
+	return filepath.Join(f.dir, url.PathEscape(key)+".json")
+}
+
+// Load returns the attributes stored for key
+func (f *File) Load(key string) (map[string]interface{}, error) {
+	f.mutex.Lock()
+	data, err := os.ReadFile(f.path(key))
+	f.mutex.Unlock()
+
+	if os.IsNotExist(err) {
+		return make(map[string]interface{}), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decode(data)
+}
+
+// Save stores the attributes for key.
+// The file is replaced at once, so a crash never leaves a half written file.
+func (f *File) Save(key string, attributes map[string]interface{}) error {
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return err
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err = tmp.Write(data); err == nil {
+		err = tmp.Close()
+	} else {
+		tmp.Close()
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
@@ -0,0 +1,56 @@
+package persistence
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Memory keeps attributes in memory. They are lost when the process ends.
+// Mostly useful for tests and for skills running on a single long living server.
+type Memory struct {
+	mutex sync.Mutex
+	data  map[string][]byte
+}
+
+// NewMemory creates an empty Memory adapter
+func NewMemory() *Memory {
+	return &Memory{
+		data: make(map[string][]byte),
+	}
+}
+
+// Load returns the attributes stored for key
+func (m *Memory) Load(key string) (map[string]interface{}, error) {
+	m.mutex.Lock()
+	data, exists := m.data[key]
+	m.mutex.Unlock()
+
+	if !exists {
+		return make(map[string]interface{}), nil
+	}
+	return decode(data)
+}
+
+// Save stores the attributes for key
+func (m *Memory) Save(key string, attributes map[string]interface{}) error {
+	// stored as json, so values come back just like from any other adapter
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	m.data[key] = data
+	m.mutex.Unlock()
+	return nil
+}
+
+func decode(data []byte) (map[string]interface{}, error) {
+	attributes := make(map[string]interface{})
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &attributes); err != nil {
+			return nil, err
+		}
+	}
+	return attributes, nil
+}
@@ -0,0 +1,75 @@
+package persistence_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/persistence"
+	"github.com/stretchr/testify/assert"
+)
+
+// localDynamoDB is a stand-in for a DynamoDB table
+type localDynamoDB struct {
+	mutex sync.Mutex
+	items map[string]map[string]string
+}
+
+func (l *localDynamoDB) GetItem(table string, key map[string]string) (map[string]string, error) {
+	if table != "skill" {
+		return nil, errors.New("table not found")
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.items[key["id"]], nil
+}
+
+func (l *localDynamoDB) PutItem(table string, item map[string]string) error {
+	if table != "skill" {
+		return errors.New("table not found")
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.items[item["id"]] = item
+	return nil
+}
+
+func roundTrip(test *assert.Assertions, adapter alexa.PersistenceAdapter) {
+	attrs, err := adapter.Load("amzn1.ask.account.user/1")
+	test.NoError(err)
+	test.Empty(attrs)
+
+	err = adapter.Save("amzn1.ask.account.user/1", map[string]interface{}{"episode": 3, "title": "Gotham"})
+	test.NoError(err)
+
+	attrs, err = adapter.Load("amzn1.ask.account.user/1")
+	test.NoError(err)
+	test.Equal(float64(3), attrs["episode"])
+	test.Equal("Gotham", attrs["title"])
+
+	attrs, err = adapter.Load("amzn1.ask.account.user/2")
+	test.NoError(err)
+	test.Empty(attrs)
+}
+
+func TestMemory(t *testing.T) {
+	roundTrip(assert.New(t), persistence.NewMemory())
+}
+
+func TestFile(t *testing.T) {
+	test := assert.New(t)
+
+	adapter, err := persistence.NewFile(t.TempDir())
+	test.NoError(err)
+	roundTrip(test, adapter)
+}
+
+func TestDynamoDB(t *testing.T) {
+	test := assert.New(t)
+
+	roundTrip(test, persistence.NewDynamoDB(&localDynamoDB{items: map[string]map[string]string{}}, "skill"))
+
+	_, err := persistence.NewDynamoDB(&localDynamoDB{}, "other").Load("user")
+	test.Error(err)
+}
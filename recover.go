@@ -0,0 +1,107 @@
+package alexa
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+)
+
+// PanicError is the error of a handler or interceptor that panicked.
+// If the panic value is an error, it is matched by errors.Is and errors.As as well.
+type PanicError struct {
+	// Value is the value passed to panic
+	Value interface{}
+	// Stack is the stack trace of the panic
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value, if it is an error
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Recovery handles the errors it matches.
+// Recoveries are tried in order, before the ErrorHandler. The Context holds a fresh response then.
+type Recovery struct {
+	// Matches determines whether Handle recovers from err
+	Matches func(err error) bool
+	// Handle responds to the user instead
+	Handle func(c *Context, err error)
+}
+
+// OnError recovers from errors matching target with errors.Is
+func OnError(target error, handle func(c *Context, err error)) Recovery {
+	return OnErrorIf(func(err error) bool { return errors.Is(err, target) }, handle)
+}
+
+// OnErrorAs recovers from errors of the type target points to, using errors.As.
+// So OnErrorAs((*alexa.PanicError)(nil), handle) recovers from all panics.
+func OnErrorAs(target interface{}, handle func(c *Context, err error)) Recovery {
+	t := reflect.TypeOf(target)
+	return OnErrorIf(func(err error) bool { return errors.As(err, reflect.New(t).Interface()) }, handle)
+}
+
+// OnErrorIf recovers from errors matches accepts
+func OnErrorIf(matches func(err error) bool, handle func(c *Context, err error)) Recovery {
+	return Recovery{Matches: matches, Handle: handle}
+}
+
+// Apology is a Recovery handler, which speaks the translation of key.
+// With keepSession the session is kept open, so the user can try again.
+func Apology(key string, keepSession bool) func(c *Context, err error) {
+	return func(c *Context, err error) {
+		if keepSession {
+			c.Ask(c.T(key))
+		} else {
+			c.Tell(c.T(key))
+		}
+	}
+}
+
+// WithError turns a handler returning an error into an IntentHandler.
+// A returned error is handled by the Recoveries or the ErrorHandler.
+func WithError(handler func(c *Context) error) IntentHandler {
+	return func(c *Context) {
+		if err := handler(c); err != nil {
+			c.Fail(err)
+		}
+	}
+}
+
+// Fail stops the handling of the request with err. The following handlers of a MultiHandler and
+// the interceptors are skipped, the error is handled by the Recoveries or the ErrorHandler.
+func (c *Context) Fail(err error) {
+	if err == nil {
+		return
+	}
+	c.err = err
+	c.abort = true
+}
+
+// safely calls f, turning a panic into a PanicError
+func (c *Context) safely(f func()) {
+	defer func() {
+		if v := recover(); v != nil {
+			c.err = &PanicError{Value: v, Stack: debug.Stack()}
+		}
+	}()
+	f()
+}
+
+// recovery finds the handler for err
+func (c *Context) recovery(err error) func(c *Context, err error) {
+	for _, r := range c.skill.Recoveries {
+		if r.Matches != nil && r.Matches(err) {
+			return r.Handle
+		}
+	}
+	return c.skill.ErrorHandler
+}
@@ -0,0 +1,107 @@
+package alexa
+
+// Predicate decides whether a request is to be handled by a Route
+type Predicate func(c *Context) bool
+
+// Route handles all requests its predicate accepts.
+// Routes are tried in order, before the Handlers are looked up by name.
+type Route struct {
+	// CanHandle determines whether Handle is the handler for the request
+	CanHandle Predicate
+	// Handle is the handler to call
+	Handle IntentHandler
+}
+
+// On creates a route for handle, used for requests canHandle accepts
+func On(canHandle Predicate, handle IntentHandler) Route {
+	return Route{CanHandle: canHandle, Handle: handle}
+}
+
+// route finds the first route able to handle the request
+func (c *Context) route() IntentHandler {
+	for _, r := range c.skill.Routes {
+		if r.CanHandle != nil && r.CanHandle(c) {
+			return r.Handle
+		}
+	}
+	return nil
+}
+
+// IsIntent accepts intent requests for one of the given intents
+func IsIntent(names ...string) Predicate {
+	return func(c *Context) bool {
+		return c.request.GetRequestType() == "IntentRequest" && contains(names, c.request.Request.Intent.Name)
+	}
+}
+
+// IsRequestType accepts requests of one of the given types, e.g. "LaunchRequest"
+func IsRequestType(types ...string) Predicate {
+	return func(c *Context) bool {
+		return contains(types, c.request.GetRequestType())
+	}
+}
+
+// DialogStateIs accepts requests within one of the given dialog states, e.g. "IN_PROGRESS"
+func DialogStateIs(states ...string) Predicate {
+	return func(c *Context) bool {
+		return contains(states, c.DialogState())
+	}
+}
+
+// AttrIs accepts requests with the session attribute key set to one of values
+func AttrIs(key string, values ...string) Predicate {
+	return func(c *Context) bool {
+		attr := c.Attr(key)
+		return attr.Exists() && contains(values, attr.String())
+	}
+}
+
+// HasSlot accepts requests with all of the given slots filled
+func HasSlot(names ...string) Predicate {
+	return func(c *Context) bool {
+		for _, name := range names {
+			if c.Slot(name).Empty() {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Supports accepts requests from devices supporting the given interface, e.g. dialog.InterfaceAPL
+func Supports(name string) Predicate {
+	return func(c *Context) bool {
+		return c.Supports(name)
+	}
+}
+
+// And accepts requests all of the predicates accept
+func And(predicates ...Predicate) Predicate {
+	return func(c *Context) bool {
+		for _, p := range predicates {
+			if !p(c) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or accepts requests any of the predicates accepts
+func Or(predicates ...Predicate) Predicate {
+	return func(c *Context) bool {
+		for _, p := range predicates {
+			if p(c) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not accepts requests the predicate does not accept
+func Not(predicate Predicate) Predicate {
+	return func(c *Context) bool {
+		return !predicate(c)
+	}
+}
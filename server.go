@@ -0,0 +1,113 @@
+package alexa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go/verify"
+)
+
+// MaxRequestSize is the maximum size of a request body accepted by ServeHTTP
+const MaxRequestSize = 1 << 20
+
+// shutdownTimeout is the time given to running requests on shutdown.
+// Alexa waits eight seconds at most for a response, so there is no need to wait longer.
+const shutdownTimeout = 10 * time.Second
+
+// ServeHTTP serves the skill as a https endpoint. Use it with any http.ServeMux:
+//
+//	http.HandleFunc("/", alexa.ServeHTTP)
+//
+// Set a Verifier to check the signatures, as Amazon requires it for skills hosted outside of Lambda.
+func ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	defaultSkill().ServeHTTP(w, r)
+}
+
+// ServeHTTP serves the skill as a https endpoint, so a Skill can be used as http.Handler.
+func (s *Skill) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body := bytes.Buffer{}
+	if _, err := body.ReadFrom(http.MaxBytesReader(w, r.Body, MaxRequestSize)); err != nil {
+		status := http.StatusBadRequest
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	resp, err := s.HandleRaw(r.Context(), r.Header, body.Bytes())
+	if err != nil {
+		http.Error(w, err.Error(), statusCode(err))
+		return
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Write(data)
+}
+
+// statusCode maps errors from handling a request to an http status code
+func statusCode(err error) int {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.Is(err, verify.ErrCertURL), errors.Is(err, verify.ErrCertificate), errors.Is(err, verify.ErrSignature):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrNilRequest), errors.Is(err, ErrInvalidTimestamp), errors.Is(err, ErrInvalidAppID):
+		return http.StatusBadRequest
+	case errors.As(err, &syntaxErr), errors.As(err, &typeErr):
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// ListenAndServe runs the given server until ctx is done.
+// It then shuts down gracefully, giving running requests and their progressive responses the time to finish.
+// If certFile and keyFile are empty, plain http is served, e.g. behind a TLS terminating load balancer.
+func ListenAndServe(ctx context.Context, srv *http.Server, certFile, keyFile string) error {
+	if srv.Handler == nil {
+		srv.Handler = http.HandlerFunc(ServeHTTP)
+	}
+
+	served := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-served:
+			// serving failed or the server was closed elsewhere, there is nothing to shut down
+			done <- nil
+			return
+		}
+		shutdown, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		done <- srv.Shutdown(shutdown)
+	}()
+
+	var err error
+	if certFile != "" || keyFile != "" {
+		err = srv.ListenAndServeTLS(certFile, keyFile)
+	} else {
+		err = srv.ListenAndServe()
+	}
+	close(served)
+	if shutdownErr := <-done; err == http.ErrServerClosed {
+		return shutdownErr
+	}
+	return err
+}
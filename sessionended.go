@@ -0,0 +1,29 @@
+package alexa
+
+import "github.com/dasjott/alexa-sdk-go/dialog"
+
+// SessionEnded tells why the session ended
+type SessionEnded struct {
+	// Reason is one of the dialog.Reason constants
+	Reason string
+	// Error is set for the reason dialog.ReasonError, e.g. dialog.ErrorInvalidResponse for a response Alexa could not use
+	Error *dialog.EchoError
+}
+
+// SessionEnded gets why the session ended. It is nil, unless the request is a SessionEndedRequest.
+func (c *Context) SessionEnded() *SessionEnded {
+	if c.request.GetRequestType() != dialog.SessionEndedRequest {
+		return nil
+	}
+	return &SessionEnded{
+		Reason: c.request.Request.Reason,
+		Error:  c.request.Request.Error,
+	}
+}
+
+// sessionEnded calls the OnSessionEnded hook for a SessionEndedRequest
+func (c *Context) sessionEnded() {
+	if ended := c.SessionEnded(); ended != nil && c.skill.OnSessionEnded != nil {
+		c.skill.OnSessionEnded(c, ended)
+	}
+}
@@ -0,0 +1,172 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/dasjott/alexa-sdk-go/verify"
+)
+
+// Skill carries everything needed to handle the requests of one skill.
+// Use it instead of the package variables, if you host more than one skill within one process.
+type Skill struct {
+	// AppID is the ID of the corresponding skill. Leave it empty to skip that check.
+	AppID string
+	// Handlers are intent functions to be called by name
+	Handlers IntentHandlers
+	// Routes are handlers with a predicate, tried in order before Handlers
+	Routes []Route
+	// LocaleStrings are all localized strings
+	LocaleStrings Localisation
+	// GetTranslation is called with the current locale code, if set. LocaleStrings is ignored then.
+	GetTranslation func(locale string) Translation
+	// DefaultLocale is used to translate for the ErrorHandler, if the locale of a request is not supported.
+	// Leave it empty for no translations then.
+	DefaultLocale string
+	// BeforeHandler is called before every intent. Call c.Abort() to skip the intent.
+	BeforeHandler func(*Context)
+	// RequestInterceptors are called in order before the handler, after BeforeHandler
+	RequestInterceptors []Interceptor
+	// ResponseInterceptors are called in order after the handler, before attributes are saved.
+	// They are called for the response of a Recovery or the ErrorHandler as well.
+	ResponseInterceptors []Interceptor
+	// ErrorHandler is called, if a request could not be handled. If not set, the error is returned by Handle.
+	ErrorHandler func(c *Context, err error)
+	// OnSessionEnded is called for every SessionEndedRequest, after the handler if there is one, even if it failed.
+	// Other than for any other request, a missing handler is not an error then. The response is dropped anyway.
+	OnSessionEnded func(c *Context, ended *SessionEnded)
+	// TimeBudget is the time a request may take. If the handler takes longer, its context is cancelled
+	// and the translation of TimeoutKey is asked instead, e.g. "This takes longer than expected, please ask me again."
+	// Leave it zero for no limit. Alexa waits about 8 seconds. An earlier deadline of the context handed to Handle shortens it.
+	TimeBudget time.Duration
+	// TimeoutKey is the key of the translation to answer with, if the TimeBudget is exceeded.
+	// It is required with a TimeBudget, Handle returns ErrNoTimeoutKey otherwise.
+	TimeoutKey string
+	// ProgressAfter is the time after which the translation of ProgressKey is sent as progressive response,
+	// if the handler is still running. Leave it zero for none.
+	ProgressAfter time.Duration
+	// ProgressKey is the key of the translation to send after ProgressAfter
+	ProgressKey string
+	// Recoveries handle the errors they match, before the ErrorHandler. Handlers may fail with c.Fail or panic.
+	Recoveries []Recovery
+	// TimestampTolerance is the maximum age of a request. Leave it zero to skip that check.
+	TimestampTolerance time.Duration
+	// Verifier checks the signature of raw requests. Leave it nil to skip that check.
+	Verifier *verify.Verifier
+	// Voice is the name of the voice used for all output. Leave it empty for the voice set by dialog.SetVoice.
+	Voice string
+	// HTTPClient is used for progressive responses and api calls. If nil, the deprecated test.RequestHandler
+	// answers them, if set, or http.DefaultClient is used.
+	HTTPClient *http.Client
+	// Persistence loads and saves the attributes of Context.PersistentAttr
+	Persistence PersistenceAdapter
+	// PersistenceKey returns the key to store persistent attributes with. If nil, UserKey is used.
+	PersistenceKey func(*Context) string
+	// Validation are the rules for slots. The handler of an intent is called only, if all of its slots are valid.
+	Validation SlotValidation
+}
+
+// defaultSkill is the skill set up by the package variables
+func defaultSkill() *Skill {
+	return &Skill{
+		AppID:              AppID,
+		Handlers:           Handlers,
+		LocaleStrings:      LocaleStrings,
+		GetTranslation:     GetTranslation,
+		DefaultLocale:      DefaultLocale,
+		BeforeHandler:      BeforeHandler,
+		ErrorHandler:       ErrorHandler,
+		TimestampTolerance: TimestampTolerance,
+		Verifier:           Verifier,
+	}
+}
+
+// Handle handles a request for this skill. It can be handed over to lambda.Start.
+// The context is available as Context.Ctx() and cancels api calls and progressive responses.
+func (s *Skill) Handle(ctx context.Context, req *dialog.EchoRequest) (*dialog.EchoResponse, error) {
+	if req == nil {
+		return nil, ErrNilRequest
+	}
+
+	if s.TimestampTolerance > 0 && !req.VerifyTimestampTolerance(s.TimestampTolerance) {
+		return nil, ErrInvalidTimestamp
+	}
+	if s.AppID != "" && !req.VerifyAppID(s.AppID) {
+		return nil, ErrInvalidAppID
+	}
+	if s.Handlers == nil && s.Routes == nil {
+		return nil, ErrNoHandlers
+	}
+	if s.TimeBudget > 0 && s.TimeoutKey == "" {
+		return nil, ErrNoTimeoutKey
+	}
+
+	trans := s.translator(req.Request.Locale)
+
+	c := Context{
+		skill:      s,
+		ctx:        ctx,
+		request:    req,
+		response:   dialog.NewResponse().SetVoice(s.Voice),
+		translator: trans,
+		voice:      s.Voice,
+		attributes: req.Session.Attributes,
+
+		System: &req.Context.System,
+		Intent: &req.Request.Intent,
+		Time:   req.GetTime(),
+	}
+
+	if req.GetRequestType() == dialog.CanFulfillIntentRequest && s.Handlers[dialog.CanFulfillIntentRequest] == nil {
+		return c.canFulfillResult()
+	}
+
+	if trans == nil {
+		// the default locale or an empty translator lets the ErrorHandler respond anyway
+		if s.DefaultLocale != "" {
+			c.translator = s.translator(s.DefaultLocale)
+		}
+		if c.translator == nil {
+			c.translator = Localisation{req.Request.Locale: Translation{}}.GetTranslator(req.Request.Locale)
+		}
+		c.err = &LocaleError{req.Request.Locale}
+		return c.getResult()
+	}
+
+	if s.TimeBudget > 0 || s.ProgressAfter > 0 {
+		return c.budgeted(req)
+	}
+	c.start(req)
+	return c.getResult()
+}
+
+func (s *Skill) translator(locale string) *Translator {
+	if s.GetTranslation != nil {
+		if langmap := s.GetTranslation(locale); langmap != nil {
+			loc := Localisation{locale: langmap}
+			return loc.GetTranslator(locale)
+		}
+	} else if s.LocaleStrings != nil {
+		return s.LocaleStrings.GetTranslator(locale)
+	}
+	return nil
+}
+
+// HandleRaw verifies the signature headers of a raw request body, if a Verifier is set, and then calls Handle.
+// Use this, if you do not host your skill on Lambda.
+func (s *Skill) HandleRaw(ctx context.Context, header http.Header, body []byte) (*dialog.EchoResponse, error) {
+	if s.Verifier != nil {
+		if err := s.Verifier.VerifyRequestContext(ctx, header, body); err != nil {
+			return nil, err
+		}
+	}
+
+	var req dialog.EchoRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+	return s.Handle(ctx, &req)
+}
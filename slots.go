@@ -1,6 +1,9 @@
 package alexa
 
 import (
+	"strings"
+	"time"
+
 	"github.com/dasjott/alexa-sdk-go/dialog"
 )
 
@@ -24,6 +27,13 @@ type Slot struct {
 	ConfirmationStatus string
 	// Match is true, if the actual speech is a match to one of this slots values or its synonyms
 	Match bool
+	// Authorities are the results of entity resolution of all authorities, static and dynamic
+	Authorities []SlotAuthority
+	// Items are the single values of a multi value slot, each with its own resolutions.
+	// ID, Value and the resolutions of the slot itself are the ones of the first item then.
+	Items []*Slot
+
+	now time.Time // time of the request to parse relative dates
 }
 
 type SlotValue struct {
@@ -31,38 +41,152 @@ type SlotValue struct {
 	Value string
 }
 
+// SlotAuthority is the result of entity resolution by one authority
+type SlotAuthority struct {
+	// Authority is the name of the authority, containing the slot type
+	Authority string
+	// Status is one of the dialog.ER constants
+	Status string
+	// Dynamic is true for the authority of dynamic entities
+	Dynamic bool
+	// Values are the matching values
+	Values []SlotValue
+}
+
+// IsMatch determines whether the authority found a matching value
+func (a *SlotAuthority) IsMatch() bool {
+	return a.Status == dialog.ERSuccessMatch
+}
+
 // Empty determines whether this slot is already filled
 func (s *Slot) Empty() bool {
-	return s.Spoken == ""
+	return s.Spoken == "" && len(s.Items) == 0
+}
+
+// setTime sets the time of the request, dates are parsed relative to
+func (s *Slot) setTime(now time.Time) *Slot {
+	s.now = now
+	for _, item := range s.Items {
+		item.now = now
+	}
+	return s
+}
+
+// IsList determines whether this is a multi value slot
+func (s *Slot) IsList() bool {
+	return s.Items != nil
+}
+
+// MatchedAuthority gets the authority that found a match, preferring dynamic entities. It is nil if there is no match.
+func (s *Slot) MatchedAuthority() *SlotAuthority {
+	var static *SlotAuthority
+	for i := range s.Authorities {
+		if auth := &s.Authorities[i]; auth.IsMatch() {
+			if auth.Dynamic {
+				return auth
+			}
+			if static == nil {
+				static = auth
+			}
+		}
+	}
+	return static
+}
+
+// BestMatch gets the first value of the matched authority. It is nil if there is no match.
+func (s *Slot) BestMatch() *SlotValue {
+	if auth := s.MatchedAuthority(); auth != nil && len(auth.Values) > 0 {
+		return &auth.Values[0]
+	}
+	return nil
+}
+
+// IsAmbiguous determines whether the spoken words match more than one value of the matched authority,
+// e.g. "Paris" in France and Texas. Ask the user which one they meant then.
+func (s *Slot) IsAmbiguous() bool {
+	auth := s.MatchedAuthority()
+	return auth != nil && len(auth.Values) > 1
+}
+
+// ResolutionFailed determines whether entity resolution failed for an error, e.g. a timeout.
+// The values are not reliable then.
+func (s *Slot) ResolutionFailed() bool {
+	for _, auth := range s.Authorities {
+		if auth.Status == dialog.ERErrorTimeout || auth.Status == dialog.ERErrorException {
+			return true
+		}
+	}
+	return false
 }
 
 func slotFromEchoSlot(es *dialog.EchoSlot) *Slot {
-	var resolution SlotValue
-	var match bool
-	var builtin = true
-	var values []SlotValue
+	var slot *Slot
+	if sv := es.SlotValue; sv != nil && sv.Type == dialog.SlotValueList {
+		slot = slotFromList(sv.Values)
+	} else if sv != nil && es.Value == "" {
+		slot = slotFromValue(sv.Value, sv.Resolutions)
+	} else {
+		slot = slotFromValue(es.Value, es.Resolutions)
+	}
+	slot.ConfirmationStatus = es.ConfirmationStatus
+	return slot
+}
 
-	if es.Resolutions != nil && len(es.Resolutions.ResolutionsPerAuthority) > 0 {
-		match = es.Resolutions.ResolutionsPerAuthority[0].IsMatch()
-		builtin = es.Resolutions.ResolutionsPerAuthority[0].IsBuiltIn()
-		for _, val := range es.Resolutions.ResolutionsPerAuthority[0].Values {
-			values = append(values, SlotValue{val.Value.ID, val.Value.Name})
+// slotFromList creates a slot from the values of a multi value slot.
+// Spoken contains all the spoken values, separated by commas.
+func slotFromList(values []dialog.EchoSlotValue) *Slot {
+	slot := &Slot{
+		Items: make([]*Slot, 0, len(values)),
+	}
+	spoken := make([]string, 0, len(values))
+	for _, val := range values {
+		item := slotFromValue(val.Value, val.Resolutions)
+		slot.Items = append(slot.Items, item)
+		spoken = append(spoken, item.Spoken)
+	}
+	if len(slot.Items) > 0 {
+		first := slot.Items[0]
+		slot.ID, slot.Value, slot.Values = first.ID, first.Value, first.Values
+		slot.Match, slot.Authorities = first.Match, first.Authorities
+	}
+	slot.Spoken = strings.Join(spoken, ", ")
+	return slot
+}
+
+func slotFromValue(value string, resolutions *dialog.EchoResolutions) *Slot {
+	slot := &Slot{
+		Spoken: value,
+	}
+
+	var builtin = true
+	if resolutions != nil {
+		for _, res := range resolutions.ResolutionsPerAuthority {
+			auth := SlotAuthority{
+				Authority: res.Authority,
+				Status:    res.Status.Code,
+				Dynamic:   res.IsDynamic(),
+			}
+			for _, val := range res.Values {
+				auth.Values = append(auth.Values, SlotValue{val.Value.ID, val.Value.Name})
+			}
+			slot.Authorities = append(slot.Authorities, auth)
 		}
-		if len(values) > 0 {
-			resolution = values[0]
+		if len(resolutions.ResolutionsPerAuthority) > 0 {
+			builtin = resolutions.ResolutionsPerAuthority[0].IsBuiltIn()
 		}
 	}
 
-	if resolution.Value == "" && builtin {
-		resolution.Value = es.Value
+	if auth := slot.MatchedAuthority(); auth != nil {
+		slot.Match = true
+		slot.Values = auth.Values
+	}
+	if best := slot.BestMatch(); best != nil {
+		slot.ID = best.ID
+		slot.Value = best.Value
 	}
 
-	return &Slot{
-		ID:                 resolution.ID,
-		Value:              resolution.Value,
-		Values:             values,
-		Spoken:             es.Value,
-		ConfirmationStatus: es.ConfirmationStatus,
-		Match:              match,
+	if slot.Value == "" && builtin {
+		slot.Value = value
 	}
+	return slot
 }
@@ -0,0 +1,282 @@
+package alexa
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// granularities of a DateRange
+const (
+	GranularityDay     = "DAY"
+	GranularityWeek    = "WEEK"
+	GranularityWeekend = "WEEKEND"
+	GranularityMonth   = "MONTH"
+	GranularitySeason  = "SEASON"
+	GranularityYear    = "YEAR"
+	GranularityDecade  = "DECADE"
+)
+
+// periods of a TimeOfDay, as sent for AMAZON.TIME
+const (
+	PeriodMorning   = "MO"
+	PeriodAfternoon = "AF"
+	PeriodEvening   = "EV"
+	PeriodNight     = "NI"
+)
+
+// periodStart is the hour each period starts at
+var periodStart = map[string]int{
+	PeriodMorning:   6,
+	PeriodAfternoon: 12,
+	PeriodEvening:   18,
+	PeriodNight:     21,
+}
+
+// DateRange is the value of an AMAZON.DATE slot.
+// Even a single day is a range, from midnight to midnight.
+type DateRange struct {
+	// Start is the first moment of the range
+	Start time.Time
+	// End is the first moment after the range
+	End time.Time
+	// Granularity is one of the Granularity constants
+	Granularity string
+}
+
+// Contains determines whether t is within the range
+func (d *DateRange) Contains(t time.Time) bool {
+	return !t.Before(d.Start) && t.Before(d.End)
+}
+
+// TimeOfDay is the value of an AMAZON.TIME slot
+type TimeOfDay struct {
+	Hour   int
+	Minute int
+	// Period is set for vague times like "in the morning". Hour is the start of the period then.
+	Period string
+}
+
+// On returns the time of day on the day of date
+func (t *TimeOfDay) On(date time.Time) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, t.Hour, t.Minute, 0, 0, date.Location())
+}
+
+// value is the value to be parsed, which is the spoken one for built in slot types
+func (s *Slot) value() (string, error) {
+	val := s.Value
+	if val == "" {
+		val = s.Spoken
+	}
+	switch val {
+	case "":
+		return "", ErrEmptySlot
+	case "?":
+		return "", ErrUnknownValue
+	}
+	return val, nil
+}
+
+func (s *Slot) reference() time.Time {
+	if s.now.IsZero() {
+		return time.Now().UTC()
+	}
+	return s.now
+}
+
+// Int gets the value of an AMAZON.NUMBER slot
+func (s *Slot) Int() (int, error) {
+	val, err := s.value()
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("%w: number %q", ErrInvalidValue, val)
+	}
+	return n, nil
+}
+
+// Float gets the value of an AMAZON.NUMBER slot as float
+func (s *Slot) Float() (float64, error) {
+	val, err := s.value()
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.ParseFloat(val, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: number %q", ErrInvalidValue, val)
+	}
+	return n, nil
+}
+
+var durationPattern = regexp.MustCompile(`^P(?:([\d.]+)Y)?(?:([\d.]+)M)?(?:([\d.]+)W)?(?:([\d.]+)D)?(?:T(?:([\d.]+)H)?(?:([\d.]+)M)?(?:([\d.]+)S)?)?$`)
+
+// durationUnits are the units of the groups in durationPattern. A year counts 365 days and a month 30.
+var durationUnits = []time.Duration{
+	365 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+	24 * time.Hour,
+	time.Hour,
+	time.Minute,
+	time.Second,
+}
+
+// Duration gets the value of an AMAZON.DURATION slot, like "PT1H30M".
+// Years count 365 days and months 30 days.
+func (s *Slot) Duration() (time.Duration, error) {
+	val, err := s.value()
+	if err != nil {
+		return 0, err
+	}
+	parts := durationPattern.FindStringSubmatch(val)
+	if parts == nil || val == "P" || strings.HasSuffix(val, "T") {
+		return 0, fmt.Errorf("%w: duration %q", ErrInvalidValue, val)
+	}
+
+	var d time.Duration
+	for i, part := range parts[1:] {
+		if part == "" {
+			continue
+		}
+		n, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: duration %q", ErrInvalidValue, val)
+		}
+		d += time.Duration(n * float64(durationUnits[i]))
+	}
+	return d, nil
+}
+
+// TimeOfDay gets the value of an AMAZON.TIME slot, like "14:30" or "MO" for morning
+func (s *Slot) TimeOfDay() (*TimeOfDay, error) {
+	val, err := s.value()
+	if err != nil {
+		return nil, err
+	}
+	if hour, exists := periodStart[val]; exists {
+		return &TimeOfDay{Hour: hour, Period: val}, nil
+	}
+
+	t, err := time.Parse("15:04", val)
+	if err != nil {
+		if t, err = time.Parse("15:04:05", val); err != nil {
+			return nil, fmt.Errorf("%w: time %q", ErrInvalidValue, val)
+		}
+	}
+	return &TimeOfDay{Hour: t.Hour(), Minute: t.Minute()}, nil
+}
+
+var seasonStart = map[string]time.Month{
+	"SP": time.March,
+	"SU": time.June,
+	"FA": time.September,
+	"WI": time.December,
+}
+
+// Date gets the value of an AMAZON.DATE slot as a range.
+// Values without a year like "XXXX-12-25" are the next occurrence, relative to the time of the request.
+func (s *Slot) Date() (*DateRange, error) {
+	val, err := s.value()
+	if err != nil {
+		return nil, err
+	}
+	now := s.reference()
+	invalid := fmt.Errorf("%w: date %q", ErrInvalidValue, val)
+
+	if val == "PRESENT_REF" {
+		return day(now.Year(), now.Month(), now.Day(), now.Location()), nil
+	}
+
+	parts := strings.Split(val, "-")
+	yearless := parts[0] == "XXXX"
+	year := now.Year()
+	if !yearless {
+		if len(parts[0]) == 4 && strings.HasSuffix(parts[0], "X") && len(parts) == 1 {
+			decade, err := strconv.Atoi(parts[0][:3])
+			if err != nil {
+				return nil, invalid
+			}
+			start := time.Date(decade*10, time.January, 1, 0, 0, 0, 0, now.Location())
+			return &DateRange{start, start.AddDate(10, 0, 0), GranularityDecade}, nil
+		}
+		if year, err = strconv.Atoi(parts[0]); err != nil || len(parts[0]) != 4 {
+			return nil, invalid
+		}
+		r, ok := dateRange(parts, year, now.Location())
+		if !ok {
+			return nil, invalid
+		}
+		return r, nil
+	}
+
+	// the next occurrence, parsed for each year, as weeks and February 29th differ from year to year
+	for y := year; y <= year+8; y++ {
+		if r, ok := dateRange(parts, y, now.Location()); ok && r.End.After(now) {
+			return r, nil
+		}
+	}
+	return nil, invalid
+}
+
+// dateRange parses the parts of a date value following the year
+func dateRange(parts []string, year int, loc *time.Location) (*DateRange, bool) {
+	switch {
+	case len(parts) == 1:
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, loc)
+		return &DateRange{start, start.AddDate(1, 0, 0), GranularityYear}, true
+
+	case len(parts) == 2 && seasonStart[parts[1]] != 0:
+		start := time.Date(year, seasonStart[parts[1]], 1, 0, 0, 0, 0, loc)
+		return &DateRange{start, start.AddDate(0, 3, 0), GranularitySeason}, true
+
+	case len(parts) == 2 && strings.HasPrefix(parts[1], "W"):
+		week, err := strconv.Atoi(parts[1][1:])
+		if err != nil || week < 1 || week > 53 {
+			return nil, false
+		}
+		start := isoWeek(year, week, loc)
+		return &DateRange{start, start.AddDate(0, 0, 7), GranularityWeek}, true
+
+	case len(parts) == 3 && strings.HasPrefix(parts[1], "W") && parts[2] == "WE":
+		week, err := strconv.Atoi(parts[1][1:])
+		if err != nil || week < 1 || week > 53 {
+			return nil, false
+		}
+		start := isoWeek(year, week, loc).AddDate(0, 0, 5)
+		return &DateRange{start, start.AddDate(0, 0, 2), GranularityWeekend}, true
+
+	case len(parts) == 2:
+		month, err := strconv.Atoi(parts[1])
+		if err != nil || month < 1 || month > 12 {
+			return nil, false
+		}
+		start := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, loc)
+		return &DateRange{start, start.AddDate(0, 1, 0), GranularityMonth}, true
+
+	case len(parts) == 3:
+		t, err := time.Parse("2006-01-02", fmt.Sprintf("%04d-%s-%s", year, parts[1], parts[2]))
+		if err != nil {
+			return nil, false
+		}
+		return day(t.Year(), t.Month(), t.Day(), loc), true
+	}
+	return nil, false
+}
+
+func day(year int, month time.Month, d int, loc *time.Location) *DateRange {
+	start := time.Date(year, month, d, 0, 0, 0, 0, loc)
+	return &DateRange{start, start.AddDate(0, 0, 1), GranularityDay}
+}
+
+// isoWeek returns the monday of the given ISO 8601 week
+func isoWeek(year, week int, loc *time.Location) time.Time {
+	// January 4th is always in week 1
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, loc)
+	weekday := int(jan4.Weekday()+6) % 7 // monday is 0
+	return jan4.AddDate(0, 0, (week-1)*7-weekday)
+}
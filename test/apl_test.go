@@ -0,0 +1,110 @@
+package test_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPL(t *testing.T) {
+	test := assert.New(t)
+
+	var sent bool
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"ShowIntent": func(c *alexa.Context) {
+				sent = c.RenderDocument("episodes", dialog.LinkDocument("doc://alexa/apl/documents/Episodes"), map[string]interface{}{
+					"episodes": []string{"one", "two"},
+				})
+				c.ExecuteCommands("episodes", map[string]interface{}{"type": "SpeakItem", "componentId": "one"})
+				c.Tell("Here are your episodes")
+			},
+		},
+	}
+
+	req := newRequest("en-US", "ShowIntent")
+	resp, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.False(sent)
+	test.Empty(resp.Response.Directives)
+
+	req.Context.System.Device.SupportedInterfaces = map[string]interface{}{
+		dialog.InterfaceAPL: map[string]interface{}{"runtime": map[string]interface{}{"maxVersion": "1.6"}},
+	}
+	resp, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.True(sent)
+
+	data, _ := json.Marshal(resp.Response.Directives)
+	test.JSONEq(`[{
+		"type": "Alexa.Presentation.APL.RenderDocument",
+		"token": "episodes",
+		"document": {"type": "Link", "src": "doc://alexa/apl/documents/Episodes"},
+		"datasources": {"episodes": ["one", "two"]}
+	}, {
+		"type": "Alexa.Presentation.APL.ExecuteCommands",
+		"token": "episodes",
+		"commands": [{"type": "SpeakItem", "componentId": "one"}]
+	}]`, string(data))
+}
+
+const userEvent = `{
+	"version": "1.0",
+	"session": {"new": false, "sessionId": "session-1"},
+	"context": {"System": {"application": {"applicationId": "skill-1"}}},
+	"request": {
+		"type": "Alexa.Presentation.APL.UserEvent",
+		"requestId": "request-1",
+		"locale": "en-US",
+		"token": "episodes",
+		"arguments": ["PlayIntent", 2],
+		"source": {"type": "TouchWrapper", "handler": "Press", "id": "episode-2"},
+		"components": {"volume": 7}
+	}
+}`
+
+func TestAPLUserEvent(t *testing.T) {
+	test := assert.New(t)
+
+	var event *alexa.UserEvent
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"PlayIntent": func(c *alexa.Context) {
+				event = c.UserEvent()
+				episode := c.Slot("episode").Value
+				if event != nil {
+					episode = event.Argument(1).String()
+				}
+				c.Tell("Playing episode " + episode)
+			},
+		},
+	}
+
+	var req dialog.EchoRequest
+	test.NoError(json.Unmarshal([]byte(userEvent), &req))
+	resp, err := skill.Handle(context.Background(), &req)
+	test.NoError(err)
+	test.Equal("<speak>Playing episode 2</speak>", resp.Response.OutputSpeech.SSML)
+	test.Equal("episodes", event.Token)
+	test.Equal("PlayIntent", event.Command())
+	test.Equal("episode-2", event.Source.ID)
+	test.Equal(float64(7), event.Components["volume"])
+	test.False(event.Argument(5).Exists())
+
+	req.Request.Arguments = []interface{}{"SkipIntent"}
+	_, err = skill.Handle(context.Background(), &req)
+	test.Equal(&alexa.HandlerError{Intent: dialog.APLUserEvent}, err)
+
+	voice := newRequest("en-US", "PlayIntent")
+	voice.Request.Intent.Slots = map[string]dialog.EchoSlot{"episode": {Name: "episode", Value: "3"}}
+	resp, err = skill.Handle(context.Background(), voice)
+	test.NoError(err)
+	test.Nil(event)
+	test.Equal("<speak>Playing episode 3</speak>", resp.Response.OutputSpeech.SSML)
+}
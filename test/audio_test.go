@@ -0,0 +1,190 @@
+package test_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudioPlayer(t *testing.T) {
+	test := assert.New(t)
+
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"PlayIntent": func(c *alexa.Context) {
+				c.AudioPlayStream(dialog.PlayBehaviorReplaceAll, dialog.AudioStream{
+					Url:                  "https://example.com/episode-1.mp3",
+					Token:                "episode-1",
+					OffsetInMilliseconds: 1500,
+				}, &dialog.AudioMetadata{
+					Title: "Episode 1",
+					Art:   *dialog.NewImage("https://example.com/art.png", "cover"),
+				}, "Playing episode 1")
+			},
+			"AMAZON.PauseIntent": func(c *alexa.Context) {
+				c.AudioStop("")
+			},
+			"ClearIntent": func(c *alexa.Context) {
+				c.AudioClearQueue(dialog.ClearBehaviorClearEnqueued)
+				c.Tell("Cleared")
+			},
+		},
+	}
+
+	resp, err := skill.Handle(context.Background(), newRequest("en-US", "PlayIntent"))
+	test.NoError(err)
+	test.Equal("<speak>Playing episode 1</speak>", resp.Response.OutputSpeech.SSML)
+	data, _ := json.Marshal(resp.Response.Directives)
+	test.JSONEq(`[{
+		"type": "AudioPlayer.Play",
+		"playBehavior": "REPLACE_ALL",
+		"audioItem": {
+			"stream": {"url": "https://example.com/episode-1.mp3", "token": "episode-1", "offsetInMilliseconds": 1500},
+			"metadata": {"title": "Episode 1", "art": {"contentDescription": "cover", "sources": [{"url": "https://example.com/art.png"}]}}
+		}
+	}]`, string(data))
+
+	resp, err = skill.Handle(context.Background(), newRequest("en-US", "AMAZON.PauseIntent"))
+	test.NoError(err)
+	test.Nil(resp.Response.OutputSpeech)
+	data, _ = json.Marshal(resp.Response.Directives)
+	test.JSONEq(`[{"type": "AudioPlayer.Stop"}]`, string(data))
+
+	resp, err = skill.Handle(context.Background(), newRequest("en-US", "ClearIntent"))
+	test.NoError(err)
+	data, _ = json.Marshal(resp.Response.Directives)
+	test.JSONEq(`[{"type": "AudioPlayer.ClearQueue", "clearBehavior": "CLEAR_ENQUEUED"}]`, string(data))
+}
+
+func TestAudioDirective(t *testing.T) {
+	test := assert.New(t)
+
+	// built by hand, as before there were helpers for it
+	data, err := json.Marshal(dialog.AudioDirective{
+		Type:          "AudioPlayer.Play",
+		PlayBehaviour: dialog.PlayBehaviorEnqueue,
+		Audioitem: dialog.EchoAudioItem{
+			Stream: dialog.AudioStream{Url: "https://example.com/episode-2.mp3", Token: "episode-2", ExpectedPreviousToken: "episode-1"},
+			Meta:   &dialog.AudioMetadata{Title: "Episode 2", BackgroundImage: *dialog.NewImage("https://example.com/bg.png", "")},
+		},
+	})
+	test.NoError(err)
+	test.JSONEq(`{
+		"type": "AudioPlayer.Play",
+		"playBehavior": "ENQUEUE",
+		"audioItem": {
+			"stream": {"url": "https://example.com/episode-2.mp3", "token": "episode-2", "expectedPreviousToken": "episode-1", "offsetInMilliseconds": 0},
+			"metadata": {"title": "Episode 2", "backgroundImage": {"sources": [{"url": "https://example.com/bg.png"}]}}
+		}
+	}`, string(data))
+
+	data, err = json.Marshal(dialog.AudioDirective{Type: "AudioPlayer.ClearQueue", ClearBehavior: dialog.ClearBehaviorClearAll})
+	test.NoError(err)
+	test.JSONEq(`{"type": "AudioPlayer.ClearQueue", "clearBehavior": "CLEAR_ALL"}`, string(data))
+}
+
+const playbackNearlyFinished = `{
+	"version": "1.0",
+	"context": {
+		"System": {"application": {"applicationId": "skill-1"}},
+		"AudioPlayer": {"token": "episode-1", "offsetInMilliseconds": 1000, "playerActivity": "PLAYING"}
+	},
+	"request": {
+		"type": "AudioPlayer.PlaybackNearlyFinished",
+		"requestId": "request-1",
+		"locale": "en-US",
+		"token": "episode-1",
+		"offsetInMilliseconds": 60000
+	}
+}`
+
+const playbackFailed = `{
+	"version": "1.0",
+	"context": {"System": {"application": {"applicationId": "skill-1"}}},
+	"request": {
+		"type": "AudioPlayer.PlaybackFailed",
+		"requestId": "request-2",
+		"locale": "en-US",
+		"token": "episode-2",
+		"error": {"type": "MEDIA_ERROR_SERVICE_UNAVAILABLE", "message": "not reachable"},
+		"currentPlaybackState": {"token": "episode-2", "offsetInMilliseconds": 0, "playerActivity": "STOPPED"}
+	}
+}`
+
+func TestAudioPlayerRequests(t *testing.T) {
+	test := assert.New(t)
+
+	var player *alexa.AudioPlayer
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			dialog.PlaybackNearlyFinished: func(c *alexa.Context) {
+				player = c.AudioPlayer()
+				c.AudioEnqueue("https://example.com/episode-2.mp3", "episode-2", player.Token)
+			},
+			dialog.PlaybackFailed: func(c *alexa.Context) {
+				player = c.AudioPlayer()
+			},
+		},
+	}
+
+	var req dialog.EchoRequest
+	test.NoError(json.Unmarshal([]byte(playbackNearlyFinished), &req))
+	resp, err := skill.Handle(context.Background(), &req)
+	test.NoError(err)
+	test.Equal("episode-1", player.Token)
+	test.Equal(dialog.PlayerActivityPlaying, player.PlayerActivity)
+	test.Equal(time.Minute, player.Offset)
+
+	data, _ := json.Marshal(resp)
+	test.JSONEq(`{"version": "1.0", "response": {"directives": [{
+		"type": "AudioPlayer.Play",
+		"playBehavior": "ENQUEUE",
+		"audioItem": {"stream": {"url": "https://example.com/episode-2.mp3", "token": "episode-2", "expectedPreviousToken": "episode-1", "offsetInMilliseconds": 0}}
+	}]}}`, string(data))
+
+	req = dialog.EchoRequest{}
+	test.NoError(json.Unmarshal([]byte(playbackFailed), &req))
+	resp, err = skill.Handle(context.Background(), &req)
+	test.NoError(err)
+	test.Equal("episode-2", player.Token)
+	test.Equal("MEDIA_ERROR_SERVICE_UNAVAILABLE", player.Error.Type)
+	test.Equal(dialog.PlayerActivityStopped, req.Request.CurrentPlaybackState.PlayerActivity)
+	test.Equal(`{"version":"1.0","response":{}}`, resp.String())
+}
+
+func TestPlaybackController(t *testing.T) {
+	test := assert.New(t)
+
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"AMAZON.PauseIntent": func(c *alexa.Context) {
+				c.AudioStop("")
+			},
+			dialog.PlaybackControllerNext: func(c *alexa.Context) {
+				c.Tell("Next episode")
+			},
+		},
+	}
+
+	req := &dialog.EchoRequest{}
+	req.Request.Type = dialog.PlaybackControllerPause
+	req.Request.Locale = "en-US"
+	test.False(req.HasSession())
+
+	resp, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal(`{"version":"1.0","response":{"directives":[{"type":"AudioPlayer.Stop"}]}}`, resp.String())
+
+	req.Request.Type = dialog.PlaybackControllerNext
+	_, err = skill.Handle(context.Background(), req)
+	test.True(errors.Is(err, dialog.ErrInvalidResponse))
+}
@@ -0,0 +1,95 @@
+package test_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+type booking struct {
+	City     string           `alexa:"city,required"`
+	CityID   string           `alexa:"city,id"`
+	Guests   int              `alexa:"guests,required"`
+	Date     *alexa.DateRange `alexa:"date,required"`
+	Stay     time.Duration    `alexa:"stay"`
+	Arrival  alexa.TimeOfDay  `alexa:"arrival"`
+	Extras   []string         `alexa:"extras"`
+	Untagged string
+}
+
+func TestBindSlots(t *testing.T) {
+	test := assert.New(t)
+
+	c := slotsAt("2026-10-18T09:30:00Z", map[string]string{
+		"guests":  "?",
+		"date":    "2026-W43-WE",
+		"stay":    "P2D",
+		"arrival": "EV",
+		"extras":  "breakfast",
+	})
+	var b booking
+	missing, err := c.BindSlots(&b)
+	test.NoError(err)
+	test.Equal([]string{"city", "guests"}, missing)
+	test.Equal(0, b.Guests)
+	test.Equal(alexa.GranularityWeekend, b.Date.Granularity)
+	test.Equal(48*time.Hour, b.Stay)
+	test.Equal(alexa.PeriodEvening, b.Arrival.Period)
+	test.Equal([]string{"breakfast"}, b.Extras)
+
+	c = slotsAt("2026-10-18T09:30:00Z", map[string]string{"guests": "many"})
+	_, err = c.BindSlots(&b)
+	test.True(errors.Is(err, alexa.ErrInvalidValue))
+
+	_, err = c.BindSlots(b)
+	test.Error(err)
+}
+
+func TestBindSlotsResolved(t *testing.T) {
+	test := assert.New(t)
+
+	var b booking
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"BookIntent": func(c *alexa.Context) {
+				missing, err := c.BindSlots(&b)
+				test.NoError(err)
+				if len(missing) > 0 {
+					c.ElicitSlot(missing[0], "How many guests?", "How many?", nil)
+					return
+				}
+				c.Tell("Booked.")
+			},
+		},
+	}
+
+	req := newRequest("en-US", "BookIntent")
+	req.Request.Timestamp = "2026-10-18T09:30:00Z"
+	req.Request.Intent.Slots = map[string]dialog.EchoSlot{
+		"city": {Name: "city", Value: "the big apple", Resolutions: &dialog.EchoResolutions{
+			ResolutionsPerAuthority: []dialog.EchoAuthorityResolution{{
+				Authority: "amzn1.er-authority.echo-sdk.amzn1.ask.skill.1.City",
+				Status: struct {
+					Code string `json:"code"`
+				}{dialog.ERSuccessMatch},
+				Values: []dialog.EchoAuthorityResolutionValue{{Value: dialog.NameID{ID: "NYC", Name: "New York"}}},
+			}},
+		}},
+		"date": {Name: "date", Value: "XXXX-12-25"},
+	}
+
+	resp, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("New York", b.City)
+	test.Equal("NYC", b.CityID)
+	test.Equal(time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), b.Date.Start)
+	if test.Len(resp.Response.Directives, 1) {
+		test.Equal("guests", resp.Response.Directives[0].(dialog.SlotDirective).SlotToElicit)
+	}
+}
@@ -0,0 +1,203 @@
+package test_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/persistence"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeBudget(t *testing.T) {
+	test := assert.New(t)
+
+	progresses := make(chan string, 1)
+	client := &http.Client{Transport: roundTripper(func(req *http.Request) *http.Response {
+		body, _ := io.ReadAll(req.Body)
+		progresses <- string(body)
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Header: http.Header{}}
+	})}
+
+	cancelled := make(chan error, 1)
+	skill := &alexa.Skill{
+		HTTPClient: client,
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{
+			"TIMEOUT":  "This takes longer than expected, please ask me again.",
+			"PROGRESS": "Just a moment.",
+		}},
+		Handlers: alexa.IntentHandlers{
+			"SlowIntent": func(c *alexa.Context) {
+				c.Attr("slow", true)
+				<-c.Ctx().Done()
+				cancelled <- c.Ctx().Err()
+				c.Tell("Too late.")
+			},
+			"ProgressIntent": func(c *alexa.Context) {
+				select {
+				case progress := <-progresses:
+					test.Contains(progress, "Just a moment.")
+					c.Tell("Done.")
+				case <-time.After(time.Second):
+					c.Tell("No progress.")
+				}
+			},
+			"FastIntent": func(c *alexa.Context) {
+				c.Tell("Fast.")
+			},
+		},
+		TimeBudget:    100 * time.Millisecond,
+		TimeoutKey:    "TIMEOUT",
+		ProgressAfter: 10 * time.Millisecond,
+		ProgressKey:   "PROGRESS",
+	}
+
+	req := newRequest("en-US", "SlowIntent")
+	req.Session.Attributes = map[string]interface{}{"count": float64(1)}
+	skill.ProgressAfter = 0
+	resp, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak>This takes longer than expected, please ask me again.</speak>", resp.Response.OutputSpeech.SSML)
+	test.Equal("<speak>This takes longer than expected, please ask me again.</speak>", resp.Response.Reprompt.OutputSpeech.SSML)
+	test.False(*resp.Response.ShouldEndSession)
+	test.Equal(map[string]interface{}{"count": float64(1)}, resp.SessionAttributes)
+	test.Equal(context.Canceled, <-cancelled)
+
+	skill.ProgressAfter = 10 * time.Millisecond
+	skill.TimeBudget = 2 * time.Second
+	req = newRequest("en-US", "ProgressIntent")
+	req.Context.System.APIEndpoint = "https://api.amazonalexa.com"
+	resp, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak>Done.</speak>", resp.Response.OutputSpeech.SSML)
+
+	resp, err = skill.Handle(context.Background(), newRequest("en-US", "FastIntent"))
+	test.NoError(err)
+	test.Equal("<speak>Fast.</speak>", resp.Response.OutputSpeech.SSML)
+
+	// a time budget without something to say is a mistake
+	skill.TimeoutKey = ""
+	_, err = skill.Handle(context.Background(), newRequest("en-US", "FastIntent"))
+	test.Equal(alexa.ErrNoTimeoutKey, err)
+	skill.TimeBudget = 0
+	_, err = skill.Handle(context.Background(), newRequest("en-US", "ProgressIntent"))
+	test.NoError(err)
+}
+
+func TestTimeBudgetDropsHandlerResult(t *testing.T) {
+	test := assert.New(t)
+
+	store := persistence.NewMemory()
+	finished := make(chan struct{})
+	var intercepted int32
+	skill := &alexa.Skill{
+		Persistence: store,
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{
+			"TIMEOUT": "Please ask me again.",
+		}},
+		Handlers: alexa.IntentHandlers{
+			"BuyIntent": func(c *alexa.Context) {
+				defer close(finished)
+				c.PersistentAttr("bought", true)
+				<-c.Ctx().Done()
+				time.Sleep(20 * time.Millisecond)
+				c.Tell("Bought.")
+			},
+		},
+		ResponseInterceptors: []alexa.Interceptor{
+			func(c *alexa.Context) {
+				atomic.AddInt32(&intercepted, 1)
+				c.PersistentAttr("visits", 1)
+			},
+		},
+		TimeBudget: 50 * time.Millisecond,
+		TimeoutKey: "TIMEOUT",
+	}
+
+	req := newRequest("en-US", "BuyIntent")
+	req.Context.System.User.ID = "user-1"
+	resp, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak>Please ask me again.</speak>", resp.Response.OutputSpeech.SSML)
+	test.Equal(int32(1), atomic.LoadInt32(&intercepted))
+
+	// the handler finishes late, but nothing of it takes effect
+	<-finished
+	time.Sleep(50 * time.Millisecond)
+	test.Equal(int32(1), atomic.LoadInt32(&intercepted))
+	attrs, err := store.Load("user-1")
+	test.NoError(err)
+	test.Equal(map[string]interface{}{"visits": float64(1)}, attrs)
+}
+
+func TestTimeBudgetDeadline(t *testing.T) {
+	test := assert.New(t)
+
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{
+			"TIMEOUT": "Please ask me again.",
+		}},
+		Handlers: alexa.IntentHandlers{
+			"SlowIntent": func(c *alexa.Context) {
+				<-c.Ctx().Done()
+			},
+		},
+		TimeBudget: time.Minute,
+		TimeoutKey: "TIMEOUT",
+	}
+
+	// the deadline comes long before the time budget
+	ctx, cancel := context.WithTimeout(context.Background(), 400*time.Millisecond)
+	defer cancel()
+	resp, err := skill.Handle(ctx, newRequest("en-US", "SlowIntent"))
+	test.NoError(err)
+	test.Equal("<speak>Please ask me again.</speak>", resp.Response.OutputSpeech.SSML)
+	test.NoError(ctx.Err(), "the fallback must be sent before the deadline")
+}
+
+func TestTimeBudgetProgressLeak(t *testing.T) {
+	test := assert.New(t)
+
+	var wg sync.WaitGroup
+	client := &http.Client{Transport: roundTripper(func(req *http.Request) *http.Response {
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Header: http.Header{}}
+	})}
+	skill := &alexa.Skill{
+		HTTPClient: client,
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{
+			"TIMEOUT": "Please ask me again.",
+		}},
+		Handlers: alexa.IntentHandlers{
+			"SlowIntent": func(c *alexa.Context) {
+				defer wg.Done()
+				c.Progress("Just a moment.")
+				<-c.Ctx().Done()
+			},
+		},
+		TimeBudget: 10 * time.Millisecond,
+		TimeoutKey: "TIMEOUT",
+	}
+
+	before := runtime.NumGoroutine()
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		req := newRequest("en-US", "SlowIntent")
+		req.Context.System.APIEndpoint = "https://api.amazonalexa.com"
+		_, err := skill.Handle(context.Background(), req)
+		test.NoError(err)
+	}
+	wg.Wait()
+
+	// abandoned handlers and their progresses finish
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > before+2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	test.LessOrEqual(runtime.NumGoroutine(), before+2)
+}
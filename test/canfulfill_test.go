@@ -0,0 +1,96 @@
+package test_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanFulfillIntent(t *testing.T) {
+	test := assert.New(t)
+
+	called := false
+	skill := &alexa.Skill{
+		Handlers: alexa.IntentHandlers{
+			"BookIntent": func(c *alexa.Context) {
+				called = true
+				c.Tell("Booked.")
+			},
+		},
+		Routes: []alexa.Route{
+			alexa.On(alexa.IsIntent("QuizIntent"), func(c *alexa.Context) { called = true }),
+		},
+		RequestInterceptors: []alexa.Interceptor{
+			func(c *alexa.Context) { called = true },
+		},
+		Validation: alexa.SlotValidation{
+			"BookIntent":   {alexa.NumberRange("guests", "ASK_GUESTS", 1, 8)},
+			"CancelIntent": {alexa.AllowedIDs("booking", "ASK_BOOKING", "B1")},
+		},
+	}
+
+	ask := func(intent string, slots map[string]dialog.EchoSlot) *dialog.EchoResponse {
+		req := newRequest("de-DE", intent)
+		req.Request.Type = dialog.CanFulfillIntentRequest
+		req.Request.Intent.Slots = slots
+		resp, err := skill.Handle(context.Background(), req)
+		test.NoError(err)
+		test.False(called)
+		return resp
+	}
+
+	resp := ask("BookIntent", map[string]dialog.EchoSlot{
+		"guests": {Name: "guests", Value: "4"},
+		"date":   {Name: "date"},
+	})
+	data, _ := json.Marshal(resp)
+	test.JSONEq(`{"version": "1.0", "response": {"canFulfillIntent": {
+		"canFulfill": "YES",
+		"slots": {"guests": {"canUnderstand": "YES", "canFulfill": "YES"}}
+	}}}`, string(data))
+
+	resp = ask("BookIntent", map[string]dialog.EchoSlot{"guests": {Name: "guests", Value: "20"}})
+	test.Equal(dialog.CanFulfillNo, resp.Response.CanFulfillIntent.CanFulfill)
+	test.Equal(dialog.CanFulfillSlot{CanUnderstand: "YES", CanFulfill: "NO"}, resp.Response.CanFulfillIntent.Slots["guests"])
+
+	resp = ask("BookIntent", map[string]dialog.EchoSlot{"room": {Name: "room", Value: "penthouse", Resolutions: &dialog.EchoResolutions{
+		ResolutionsPerAuthority: []dialog.EchoAuthorityResolution{{
+			Authority: "amzn1.er-authority.echo-sdk.amzn1.ask.skill.1.Room",
+			Status: struct {
+				Code string `json:"code"`
+			}{dialog.ERSuccessNoMatch},
+		}},
+	}}})
+	test.Equal(dialog.CanFulfillMaybe, resp.Response.CanFulfillIntent.CanFulfill)
+	test.Equal(dialog.CanFulfillMaybe, resp.Response.CanFulfillIntent.Slots["room"].CanUnderstand)
+
+	test.Equal(dialog.CanFulfillYes, ask("QuizIntent", nil).Response.CanFulfillIntent.CanFulfill)
+	test.Equal(dialog.CanFulfillNo, ask("WeatherIntent", nil).Response.CanFulfillIntent.CanFulfill)
+	// validation rules alone do not make a handler
+	test.Equal(dialog.CanFulfillNo, ask("CancelIntent", nil).Response.CanFulfillIntent.CanFulfill)
+
+	// a handler of its own may adjust the answer
+	skill.Handlers[dialog.CanFulfillIntentRequest] = func(c *alexa.Context) {
+		answer := c.CanFulfillIntent()
+		if c.Intent.Name == "WeatherIntent" {
+			answer.CanFulfill = dialog.CanFulfillMaybe
+		}
+		c.CanFulfill(answer)
+	}
+	skill.RequestInterceptors = nil
+	skill.LocaleStrings = alexa.Localisation{"de-DE": alexa.Translation{}}
+	test.Equal(dialog.CanFulfillMaybe, ask("WeatherIntent", nil).Response.CanFulfillIntent.CanFulfill)
+
+	skill.Handlers[dialog.CanFulfillIntentRequest] = func(c *alexa.Context) {
+		c.CanFulfill(c.CanFulfillIntent())
+		c.Tell("not allowed")
+	}
+	req := newRequest("de-DE", "BookIntent")
+	req.Request.Type = dialog.CanFulfillIntentRequest
+	_, err := skill.Handle(context.Background(), req)
+	test.ErrorIs(err, dialog.ErrInvalidResponse)
+}
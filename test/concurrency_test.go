@@ -0,0 +1,58 @@
+package test_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConcurrency is meant to be run with the race detector: go test -race ./...
+func TestConcurrency(t *testing.T) {
+	test := assert.New(t)
+
+	client := &http.Client{Transport: roundTripper(func(req *http.Request) *http.Response {
+		return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: http.Header{}}
+	})}
+
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{
+			"HELLO":    []string{"Hello.", "Hi.", "Howdy."},
+			"PROGRESS": []string{"Wait.", "One moment."},
+		}},
+		HTTPClient: client,
+		Handlers: alexa.IntentHandlers{
+			"HelloIntent": func(c *alexa.Context) {
+				c.Progress(c.T("PROGRESS"))
+				c.SetVoice("Joey")
+				c.Ask(c.T("HELLO"), "What?", "Pardon?")
+			},
+		},
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			req := newRequest("en-US", "HelloIntent")
+			req.Context.System.APIEndpoint = "https://api.amazonalexa.com"
+			resp, err := skill.Handle(context.Background(), req)
+			test.NoError(err)
+			test.Contains(resp.Response.OutputSpeech.SSML, "<voice name=\"Joey\">")
+		}()
+		go func() {
+			defer wg.Done()
+			dialog.SetVoice("Hans")
+			dialog.NewResponse().OutputSSML("concurrent")
+			dialog.SetVoice("")
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,134 @@
+package test_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type ctxKey struct{}
+
+// ctxTransport fails like the real transport, if the context of the request is done
+type ctxTransport func(*http.Request) *http.Response
+
+func (rt ctxTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	return rt(req), nil
+}
+
+func TestContextPropagation(t *testing.T) {
+	test := assert.New(t)
+
+	deadlines := make(chan time.Time, 4)
+	client := &http.Client{Transport: ctxTransport(func(req *http.Request) *http.Response {
+		deadline, _ := req.Context().Deadline()
+		deadlines <- deadline
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"countryCode":"DE","postalCode":"12345"}`)),
+			Header:     http.Header{},
+		}
+	})}
+
+	var value interface{}
+	var apiErr error
+	skill := &alexa.Skill{
+		HTTPClient:    client,
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"WhereIntent": func(c *alexa.Context) {
+				value = c.Ctx().Value(ctxKey{})
+				c.Progress("Let me see.")
+				_, apiErr = alexa.API(c).GetRegionAndZip()
+				c.Tell("Here.")
+			},
+		},
+	}
+
+	req := newRequest("en-US", "WhereIntent")
+	req.Context.System.APIEndpoint = "https://api.amazonalexa.com"
+
+	deadline := time.Now().Add(8 * time.Second)
+	ctx, cancel := context.WithDeadline(context.WithValue(context.Background(), ctxKey{}, "lambda"), deadline)
+	defer cancel()
+	_, err := skill.Handle(ctx, req)
+	test.NoError(err)
+	test.NoError(apiErr)
+	test.Equal("lambda", value)
+	test.Equal(deadline, <-deadlines)
+	test.Equal(deadline, <-deadlines)
+
+	// a cancelled context cancels the api call
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = skill.Handle(ctx, req)
+	test.NoError(err)
+	test.True(errors.Is(apiErr, context.Canceled))
+
+	// without a context
+	_, err = skill.Handle(nil, req)
+	test.NoError(err)
+	test.NoError(apiErr)
+	test.Nil(value)
+}
+
+// trackedBody counts how often it is read to the end and closed
+type trackedBody struct {
+	io.Reader
+	drained, closed *int32
+}
+
+func (b trackedBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		atomic.AddInt32(b.drained, 1)
+	}
+	return n, err
+}
+
+func (b trackedBody) Close() error {
+	atomic.AddInt32(b.closed, 1)
+	return nil
+}
+
+func TestResponseBodiesClosed(t *testing.T) {
+	test := assert.New(t)
+
+	var drained, closed int32
+	client := &http.Client{Transport: ctxTransport(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       trackedBody{strings.NewReader(`{"countryCode":"DE","postalCode":"12345"}`), &drained, &closed},
+			Header:     http.Header{},
+		}
+	})}
+
+	skill := &alexa.Skill{
+		HTTPClient:    client,
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"WhereIntent": func(c *alexa.Context) {
+				c.Progress("Let me see.")
+				alexa.API(c).GetRegionAndZip()
+				c.Tell("Here.")
+			},
+		},
+	}
+
+	req := newRequest("en-US", "WhereIntent")
+	req.Context.System.APIEndpoint = "https://api.amazonalexa.com"
+	_, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal(int32(2), atomic.LoadInt32(&drained))
+	test.Equal(int32(2), atomic.LoadInt32(&closed))
+}
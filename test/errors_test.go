@@ -0,0 +1,75 @@
+package test_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRequest(locale, intent string) *dialog.EchoRequest {
+	req := &dialog.EchoRequest{}
+	req.Request.Type = "IntentRequest"
+	req.Request.Locale = locale
+	req.Request.Intent.Name = intent
+	req.Context.System.Application.ID = "skill-1"
+	return req
+}
+
+func TestErrors(t *testing.T) {
+	test := assert.New(t)
+
+	alexa.LocaleStrings = alexa.Localisation{"en-US": alexa.Translation{"SORRY": "Sorry."}}
+	alexa.Handlers = alexa.IntentHandlers{
+		"HelloIntent": func(c *alexa.Context) {
+			c.Tell("Hello.")
+		},
+	}
+	defer func() {
+		alexa.AppID, alexa.LocaleStrings, alexa.Handlers, alexa.ErrorHandler = "", nil, nil, nil
+	}()
+
+	_, err := alexa.Handle(context.Background(), nil)
+	test.Equal(alexa.ErrNilRequest, err)
+
+	alexa.AppID = "skill-2"
+	_, err = alexa.Handle(context.Background(), newRequest("en-US", "HelloIntent"))
+	test.Equal(alexa.ErrInvalidAppID, err)
+	alexa.AppID = ""
+
+	_, err = alexa.Handle(context.Background(), newRequest("en-US", "ByeIntent"))
+	test.True(errors.Is(err, alexa.ErrNoHandler))
+	var handlerErr *alexa.HandlerError
+	test.True(errors.As(err, &handlerErr))
+	test.Equal("ByeIntent", handlerErr.Intent)
+
+	_, err = alexa.Handle(context.Background(), newRequest("xx-XX", "HelloIntent"))
+	test.True(errors.Is(err, alexa.ErrUnsupportedLocale))
+
+	var handled error
+	alexa.ErrorHandler = func(c *alexa.Context, err error) {
+		handled = err
+		c.Tell(c.T("SORRY"))
+	}
+	resp, err := alexa.Handle(context.Background(), newRequest("en-US", "ByeIntent"))
+	test.NoError(err)
+	test.True(errors.Is(handled, alexa.ErrNoHandler))
+	test.Contains(resp.Response.OutputSpeech.SSML, "Sorry.")
+
+	// no translations for an unsupported locale
+	resp, err = alexa.Handle(context.Background(), newRequest("xx-XX", "HelloIntent"))
+	test.NoError(err)
+	test.True(errors.Is(handled, alexa.ErrUnsupportedLocale))
+	test.Equal("<speak></speak>", resp.Response.OutputSpeech.SSML)
+
+	// unless there is a default locale
+	alexa.DefaultLocale = "en-US"
+	defer func() { alexa.DefaultLocale = "" }()
+	resp, err = alexa.Handle(context.Background(), newRequest("xx-XX", "HelloIntent"))
+	test.NoError(err)
+	test.True(errors.Is(handled, alexa.ErrUnsupportedLocale))
+	test.Equal("<speak>Sorry.</speak>", resp.Response.OutputSpeech.SSML)
+}
@@ -2,5 +2,8 @@ package test
 
 import "net/http"
 
-// RequestHandler is a handler used for testing purposes
+// RequestHandler is a handler used for testing purposes.
+// It answers api calls and progressive responses, unless an http.Client is set, e.g. as Skill.HTTPClient.
+//
+// Deprecated: set Skill.HTTPClient to an http.Client with a custom Transport instead.
 var RequestHandler func(*http.Request) *http.Response
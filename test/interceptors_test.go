@@ -0,0 +1,125 @@
+package test_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterceptors(t *testing.T) {
+	test := assert.New(t)
+
+	var log []string
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{"LINK": "Please link your account."}},
+		Handlers: alexa.IntentHandlers{
+			"HelloIntent": func(c *alexa.Context) {
+				log = append(log, "handler")
+				c.Tell("Hello " + c.Attr("name").String() + ".")
+			},
+			"SessionEndedRequest": func(c *alexa.Context) {
+				log = append(log, "ended")
+			},
+		},
+		RequestInterceptors: []alexa.Interceptor{
+			func(c *alexa.Context) {
+				log = append(log, "request "+c.Request().GetRequestType())
+				c.Attr("name", "Jane")
+			},
+			func(c *alexa.Context) {
+				if c.System.User.AccessToken == "" {
+					c.Tell(c.T("LINK")).LinkAccountCard()
+					c.Abort()
+				}
+			},
+			func(c *alexa.Context) {
+				log = append(log, "skipped on abort")
+			},
+		},
+		ResponseInterceptors: []alexa.Interceptor{
+			func(c *alexa.Context) {
+				log = append(log, "response")
+				if c.Response().Response.OutputSpeech == nil {
+					return
+				}
+				c.Response().Response.OutputSpeech.SSML += "<!-- logged -->"
+			},
+			func(c *alexa.Context) {
+				if c.Intent.Name == "SecretIntent" {
+					c.SetResponse(dialog.NewResponse().OutputSSML("No secrets."))
+				}
+			},
+		},
+	}
+
+	req := newRequest("en-US", "HelloIntent")
+	resp, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal([]string{"request IntentRequest", "response"}, log)
+	test.Equal("<speak>Please link your account.</speak><!-- logged -->", resp.Response.OutputSpeech.SSML)
+	test.Equal("LinkAccount", resp.Response.Card.Type)
+
+	log = nil
+	req.Context.System.User.AccessToken = "token"
+	resp, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal([]string{"request IntentRequest", "skipped on abort", "handler", "response"}, log)
+	test.Equal("<speak>Hello Jane.</speak><!-- logged -->", resp.Response.OutputSpeech.SSML)
+
+	log = nil
+	req.Request.Intent.Name = "SecretIntent"
+	skill.Handlers["SecretIntent"] = skill.Handlers["HelloIntent"]
+	resp, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak>No secrets.</speak>", resp.Response.OutputSpeech.SSML)
+	test.Equal("Jane", resp.SessionAttributes["name"])
+
+	log = nil
+	req = newRequest("en-US", "")
+	req.Request.Type = "SessionEndedRequest"
+	req.Context.System.User.AccessToken = "token"
+	_, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal([]string{"request SessionEndedRequest", "skipped on abort", "ended", "response"}, log)
+}
+
+func TestInterceptErrorResponse(t *testing.T) {
+	test := assert.New(t)
+
+	var seen []string
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{"SORRY": "Sorry."}},
+		Handlers: alexa.IntentHandlers{
+			"HelloIntent": func(c *alexa.Context) {
+				c.Tell("Hello.")
+			},
+		},
+		ErrorHandler: func(c *alexa.Context, err error) {
+			c.Tell(c.T("SORRY"))
+		},
+		ResponseInterceptors: []alexa.Interceptor{
+			func(c *alexa.Context) {
+				if speech := c.Response().Response.OutputSpeech; speech != nil {
+					seen = append(seen, speech.SSML)
+					speech.SSML += "<!-- logged -->"
+				}
+			},
+		},
+	}
+
+	resp, err := skill.Handle(context.Background(), newRequest("en-US", "ByeIntent"))
+	test.NoError(err)
+	test.Equal([]string{"<speak>Sorry.</speak>"}, seen)
+	test.Equal("<speak>Sorry.</speak><!-- logged -->", resp.Response.OutputSpeech.SSML)
+
+	// the apology for an unsupported locale as well
+	seen = nil
+	skill.DefaultLocale = "en-US"
+	resp, err = skill.Handle(context.Background(), newRequest("xx-XX", "HelloIntent"))
+	test.NoError(err)
+	test.Equal([]string{"<speak>Sorry.</speak>"}, seen)
+	test.Equal("<speak>Sorry.</speak><!-- logged -->", resp.Response.OutputSpeech.SSML)
+}
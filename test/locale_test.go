@@ -64,6 +64,7 @@ func TestVoice(t *testing.T) {
 	test.Equal("<speak>I am Batman</speak>", resp.Response.OutputSpeech.SSML)
 
 	dialog.SetVoice("Alfred")
+	defer dialog.SetVoice("")
 	resp.OutputSSML("take care, master bruce")
 	test.Equal("<speak><voice name=\"Alfred\">take care, master bruce</voice></speak>", resp.Response.OutputSpeech.SSML)
 }
@@ -0,0 +1,78 @@
+package test_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/persistence"
+	"github.com/stretchr/testify/assert"
+)
+
+type countingAdapter struct {
+	alexa.PersistenceAdapter
+	loads, saves int
+}
+
+func (a *countingAdapter) Load(key string) (map[string]interface{}, error) {
+	a.loads++
+	return a.PersistenceAdapter.Load(key)
+}
+
+func (a *countingAdapter) Save(key string, attributes map[string]interface{}) error {
+	a.saves++
+	return a.PersistenceAdapter.Save(key, attributes)
+}
+
+func TestPersistentAttributes(t *testing.T) {
+	test := assert.New(t)
+
+	adapter := &countingAdapter{PersistenceAdapter: persistence.NewMemory()}
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Persistence:   adapter,
+		Handlers: alexa.IntentHandlers{
+			"NextIntent": func(c *alexa.Context) {
+				episode := c.PersistentAttr("episode").Int() + 1
+				c.PersistentAttr("episode", episode)
+				c.Tell(c.PersistentAttr("episode").String())
+			},
+			"ListenIntent": func(c *alexa.Context) {
+				c.Tell(c.PersistentAttr("episode").String())
+			},
+			"HelpIntent": func(c *alexa.Context) {
+				c.Ask("How can I help?")
+			},
+		},
+	}
+
+	req := newRequest("en-US", "NextIntent")
+	req.Context.System.User.ID = "user-1"
+
+	resp, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak>1</speak>", resp.Response.OutputSpeech.SSML)
+	resp, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak>2</speak>", resp.Response.OutputSpeech.SSML)
+	test.Equal(2, adapter.loads)
+	test.Equal(2, adapter.saves)
+
+	req.Request.Intent.Name = "ListenIntent"
+	resp, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak>2</speak>", resp.Response.OutputSpeech.SSML)
+	test.Equal(3, adapter.loads)
+	test.Equal(2, adapter.saves)
+
+	req.Request.Intent.Name = "HelpIntent"
+	_, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal(3, adapter.loads)
+
+	req.Request.Intent.Name = "ListenIntent"
+	req.Context.System.User.ID = "user-2"
+	resp, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak></speak>", resp.Response.OutputSpeech.SSML)
+}
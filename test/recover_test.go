@@ -0,0 +1,98 @@
+package test_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var errAddress = errors.New("address not reachable")
+
+type quotaError struct{ left int }
+
+func (e *quotaError) Error() string { return "quota exceeded" }
+
+func TestRecoveries(t *testing.T) {
+	test := assert.New(t)
+
+	var unhandled error
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{
+			"NO_ADDRESS": "Sorry, I couldn't reach your address.",
+			"QUOTA":      "Sorry, try again tomorrow.",
+			"OOPS":       "Oops.",
+		}},
+		Handlers: alexa.IntentHandlers{
+			"AddressIntent": alexa.WithError(func(c *alexa.Context) error {
+				return errAddress
+			}),
+			"QuotaIntent": alexa.MultiHandler(
+				func(c *alexa.Context) { c.Fail(&quotaError{3}) },
+				func(c *alexa.Context) { c.Tell("not reached") },
+			),
+			"PanicIntent": func(c *alexa.Context) {
+				var m map[string]int
+				m["boom"]++
+			},
+			"FineIntent": alexa.WithError(func(c *alexa.Context) error {
+				c.Tell("Fine.")
+				return nil
+			}),
+			"OtherIntent": alexa.WithError(func(c *alexa.Context) error {
+				return errors.New("other")
+			}),
+		},
+		Recoveries: []alexa.Recovery{
+			alexa.OnError(errAddress, alexa.Apology("NO_ADDRESS", true)),
+			alexa.OnErrorAs((*quotaError)(nil), alexa.Apology("QUOTA", false)),
+			alexa.OnErrorIf(func(err error) bool {
+				var p *alexa.PanicError
+				return errors.As(err, &p) && len(p.Stack) > 0
+			}, alexa.Apology("OOPS", false)),
+		},
+	}
+
+	resp, err := skill.Handle(context.Background(), newRequest("en-US", "AddressIntent"))
+	test.NoError(err)
+	test.Equal("<speak>Sorry, I couldn't reach your address.</speak>", resp.Response.OutputSpeech.SSML)
+	test.False(*resp.Response.ShouldEndSession)
+
+	resp, err = skill.Handle(context.Background(), newRequest("en-US", "QuotaIntent"))
+	test.NoError(err)
+	test.Equal("<speak>Sorry, try again tomorrow.</speak>", resp.Response.OutputSpeech.SSML)
+	test.True(*resp.Response.ShouldEndSession)
+
+	resp, err = skill.Handle(context.Background(), newRequest("en-US", "PanicIntent"))
+	test.NoError(err)
+	test.Equal("<speak>Oops.</speak>", resp.Response.OutputSpeech.SSML)
+
+	resp, err = skill.Handle(context.Background(), newRequest("en-US", "FineIntent"))
+	test.NoError(err)
+	test.Equal("<speak>Fine.</speak>", resp.Response.OutputSpeech.SSML)
+
+	// no recovery, no ErrorHandler
+	_, err = skill.Handle(context.Background(), newRequest("en-US", "OtherIntent"))
+	test.EqualError(err, "other")
+
+	// the ErrorHandler is the last resort
+	skill.ErrorHandler = func(c *alexa.Context, err error) {
+		unhandled = err
+		c.Tell("Sorry.")
+	}
+	resp, err = skill.Handle(context.Background(), newRequest("en-US", "OtherIntent"))
+	test.NoError(err)
+	test.EqualError(unhandled, "other")
+	test.Equal("<speak>Sorry.</speak>", resp.Response.OutputSpeech.SSML)
+
+	// a panicking recovery fails the request
+	skill.Recoveries = []alexa.Recovery{alexa.OnError(errAddress, func(c *alexa.Context, err error) {
+		panic(err)
+	})}
+	_, err = skill.Handle(context.Background(), newRequest("en-US", "AddressIntent"))
+	var p *alexa.PanicError
+	test.True(errors.As(err, &p))
+	test.ErrorIs(err, errAddress)
+}
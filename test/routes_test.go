@@ -0,0 +1,72 @@
+package test_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoutes(t *testing.T) {
+	test := assert.New(t)
+
+	var handled string
+	handle := func(name string) alexa.IntentHandler {
+		return func(c *alexa.Context) {
+			handled = name
+			c.Tell(name)
+		}
+	}
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Routes: []alexa.Route{
+			alexa.On(alexa.And(alexa.IsIntent("AMAZON.YesIntent"), alexa.AttrIs("state", "quiz")), handle("answer")),
+			alexa.On(alexa.And(alexa.IsIntent("OrderIntent"), alexa.DialogStateIs("STARTED", "IN_PROGRESS")), handle("dialog")),
+			alexa.On(alexa.And(alexa.IsIntent("OrderIntent"), alexa.HasSlot("item"), alexa.Supports(dialog.InterfaceAPL)), handle("screen")),
+			alexa.On(alexa.Or(alexa.IsRequestType("LaunchRequest"), alexa.IsIntent("AMAZON.HelpIntent")), handle("welcome")),
+			alexa.On(alexa.Not(alexa.IsRequestType("IntentRequest", "LaunchRequest")), handle("other")),
+		},
+		Handlers: alexa.IntentHandlers{
+			"OrderIntent":      handle("order"),
+			"AMAZON.YesIntent": handle("yes"),
+		},
+	}
+
+	run := func(req *dialog.EchoRequest) string {
+		handled = ""
+		_, err := skill.Handle(context.Background(), req)
+		test.NoError(err)
+		return handled
+	}
+
+	req := newRequest("en-US", "AMAZON.YesIntent")
+	test.Equal("yes", run(req))
+	req.Session.Attributes = map[string]interface{}{"state": "quiz"}
+	test.Equal("answer", run(req))
+
+	req = newRequest("en-US", "OrderIntent")
+	test.Equal("order", run(req))
+	req.Request.DialogState = "IN_PROGRESS"
+	test.Equal("dialog", run(req))
+	req.Request.DialogState = "COMPLETED"
+	req.Request.Intent.Slots = map[string]dialog.EchoSlot{"item": {Name: "item", Value: "pizza"}}
+	test.Equal("order", run(req))
+	req.Context.System.Device.SupportedInterfaces = map[string]interface{}{dialog.InterfaceAPL: map[string]interface{}{}}
+	test.Equal("screen", run(req))
+
+	req = newRequest("en-US", "LaunchRequest")
+	req.Request.Type = "LaunchRequest"
+	test.Equal("welcome", run(req))
+	test.Equal("welcome", run(newRequest("en-US", "AMAZON.HelpIntent")))
+
+	req = newRequest("en-US", "SessionEndedRequest")
+	req.Request.Type = "SessionEndedRequest"
+	test.Equal("other", run(req))
+
+	// routes alone are enough
+	skill.Handlers = nil
+	_, err := skill.Handle(context.Background(), newRequest("en-US", "AMAZON.StopIntent"))
+	test.ErrorIs(err, alexa.ErrNoHandler)
+}
@@ -0,0 +1,265 @@
+package test_test
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/dasjott/alexa-sdk-go/verify"
+	"github.com/stretchr/testify/assert"
+)
+
+const launchRequest = `{
+	"version": "1.0",
+	"session": {"new": true, "sessionId": "session-1"},
+	"request": {"type": "LaunchRequest", "requestId": "request-1", "locale": "en-US", "timestamp": "2019-01-01T00:00:00Z"},
+	"context": {"System": {"application": {"applicationId": "skill-1"}}}
+}`
+
+func TestServer(t *testing.T) {
+	test := assert.New(t)
+
+	alexa.AppID = "skill-1"
+	alexa.LocaleStrings = alexa.Localisation{"en-US": alexa.Translation{"HELLO": "Hello."}}
+	alexa.Handlers = alexa.IntentHandlers{
+		"LaunchRequest": func(c *alexa.Context) {
+			c.Tell(c.T("HELLO"))
+		},
+	}
+	defer func() {
+		alexa.AppID, alexa.LocaleStrings, alexa.Handlers = "", nil, nil
+	}()
+
+	srv := httptest.NewServer(http.HandlerFunc(alexa.ServeHTTP))
+	defer srv.Close()
+
+	resp, err := http.Post(srv.URL, "application/json", strings.NewReader(launchRequest))
+	test.NoError(err)
+	test.Equal(http.StatusOK, resp.StatusCode)
+	test.Equal("application/json;charset=UTF-8", resp.Header.Get("Content-Type"))
+
+	var echo dialog.EchoResponse
+	test.NoError(json.NewDecoder(resp.Body).Decode(&echo))
+	resp.Body.Close()
+	test.Contains(echo.Response.OutputSpeech.SSML, "Hello.")
+
+	resp, err = http.Post(srv.URL, "application/json", strings.NewReader("{not json"))
+	test.NoError(err)
+	test.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Post(srv.URL, "application/json", strings.NewReader(strings.Repeat(" ", alexa.MaxRequestSize+1)))
+	test.NoError(err)
+	test.Equal(http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	resp, err = http.Get(srv.URL)
+	test.NoError(err)
+	test.Equal(http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestListenAndServeFails(t *testing.T) {
+	test := assert.New(t)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !test.NoError(err) {
+		return
+	}
+	defer l.Close()
+
+	// the port is in use, so serving fails right away and must not wait for ctx
+	srv := &http.Server{Addr: l.Addr().String()}
+	done := make(chan error, 1)
+	go func() { done <- alexa.ListenAndServe(context.Background(), srv, "", "") }()
+
+	select {
+	case err := <-done:
+		test.Error(err)
+	case <-time.After(5 * time.Second):
+		test.Fail("ListenAndServe did not return")
+	}
+}
+
+func TestListenAndServeShutdown(t *testing.T) {
+	test := assert.New(t)
+
+	// a free port to serve on
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !test.NoError(err) {
+		return
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	srv := &http.Server{Addr: addr, Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte("finished"))
+	})}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- alexa.ListenAndServe(ctx, srv, "", "") }()
+
+	// the running request is finished before the server shuts down
+	responses := make(chan string, 1)
+	go func() {
+		for {
+			resp, err := http.Post("http://"+addr, "application/json", strings.NewReader("{}"))
+			if err != nil {
+				time.Sleep(10 * time.Millisecond)
+				continue
+			}
+			data, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			responses <- string(data)
+			return
+		}
+	}()
+	<-started
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	select {
+	case err := <-done:
+		test.NoError(err)
+		test.Equal("finished", <-responses)
+	case <-time.After(5 * time.Second):
+		test.Fail("ListenAndServe did not return")
+	}
+
+	// closed elsewhere, while ctx is never done
+	srv = &http.Server{Addr: addr}
+	go func() { done <- alexa.ListenAndServe(context.Background(), srv, "", "") }()
+	time.Sleep(50 * time.Millisecond)
+	srv.Close()
+
+	select {
+	case err := <-done:
+		test.NoError(err)
+	case <-time.After(5 * time.Second):
+		test.Fail("ListenAndServe did not return")
+	}
+}
+
+// signer creates a certificate chain for echo-api.amazon.com and signs bodies with it
+type signer struct {
+	roots *x509.CertPool
+	chain []byte
+	key   *rsa.PrivateKey
+}
+
+func newSigner(t *testing.T) *signer {
+	rootKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	rootTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, rootTmpl, rootTmpl, &rootKey.PublicKey, rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, _ := x509.ParseCertificate(der)
+
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	der, err = x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "echo-api.amazon.com"},
+		DNSNames:     []string{"echo-api.amazon.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}, root, &key.PublicKey, rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	return &signer{roots, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), key}
+}
+
+func (s *signer) sign(body string) string {
+	sum := sha256.Sum256([]byte(body))
+	sig, _ := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, sum[:])
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestServerVerifier(t *testing.T) {
+	test := assert.New(t)
+
+	s := newSigner(t)
+	fetcher := &verify.HTTPFetcher{Client: &http.Client{Transport: roundTripper(func(req *http.Request) *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(s.chain)), Header: http.Header{}}
+	})}}
+	skill := &alexa.Skill{
+		AppID:         "skill-1",
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"LaunchRequest": func(c *alexa.Context) {
+				c.Tell("Hello.")
+			},
+		},
+		Verifier: &verify.Verifier{Fetcher: verify.NewCachingFetcher(fetcher), Roots: s.roots},
+	}
+	srv := httptest.NewServer(skill)
+	defer srv.Close()
+
+	post := func(signature string) *http.Response {
+		req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(launchRequest))
+		req.Header.Set(verify.HeaderCertChainURL, "https://s3.amazonaws.com/echo.api/echo-api-cert.pem")
+		req.Header.Set(verify.HeaderSignature256, signature)
+		resp, err := http.DefaultClient.Do(req)
+		if !test.NoError(err) {
+			t.FailNow()
+		}
+		return resp
+	}
+
+	resp := post(s.sign(launchRequest))
+	test.Equal(http.StatusOK, resp.StatusCode)
+	var echo dialog.EchoResponse
+	test.NoError(json.NewDecoder(resp.Body).Decode(&echo))
+	resp.Body.Close()
+	test.Equal("<speak>Hello.</speak>", echo.Response.OutputSpeech.SSML)
+
+	resp = post(s.sign(`{"version":"1.0"}`))
+	resp.Body.Close()
+	test.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	// the download of the certificates is cancelled with the context of the request
+	skill.Verifier.Fetcher = &verify.HTTPFetcher{Client: &http.Client{Transport: ctxTransport(func(req *http.Request) *http.Response {
+		test.Fail("certificates downloaded with a cancelled context")
+		return nil
+	})}}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	header := http.Header{}
+	header.Set(verify.HeaderCertChainURL, "https://s3.amazonaws.com/echo.api/echo-api-cert.pem")
+	header.Set(verify.HeaderSignature256, s.sign(launchRequest))
+	_, err := skill.HandleRaw(ctx, header, []byte(launchRequest))
+	test.True(errors.Is(err, verify.ErrCertificate))
+}
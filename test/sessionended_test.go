@@ -0,0 +1,85 @@
+package test_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionEnded(t *testing.T) {
+	test := assert.New(t)
+
+	var ended *alexa.SessionEnded
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"HelloIntent": func(c *alexa.Context) {
+				test.Nil(c.SessionEnded())
+				c.Tell("Hello.")
+			},
+		},
+		OnSessionEnded: func(c *alexa.Context, e *alexa.SessionEnded) {
+			ended = e
+			c.Tell("nobody hears this")
+		},
+	}
+
+	var req dialog.EchoRequest
+	err := json.Unmarshal([]byte(`{
+		"version": "1.0",
+		"session": {"new": false, "sessionId": "session-1"},
+		"context": {"System": {"application": {"applicationId": "skill-1"}}},
+		"request": {
+			"type": "SessionEndedRequest",
+			"requestId": "request-1",
+			"timestamp": "2026-10-18T09:30:00Z",
+			"locale": "en-US",
+			"reason": "ERROR",
+			"error": {"type": "INVALID_RESPONSE", "message": "SSML is invalid"}
+		}
+	}`), &req)
+	test.NoError(err)
+
+	resp, err := skill.Handle(context.Background(), &req)
+	test.NoError(err)
+	if test.NotNil(ended) {
+		test.Equal(dialog.ReasonError, ended.Reason)
+		test.Equal(&dialog.EchoError{Type: dialog.ErrorInvalidResponse, Message: "SSML is invalid"}, ended.Error)
+	}
+	data, _ := json.Marshal(resp)
+	test.JSONEq(`{"version": "1.0", "response": {}}`, string(data))
+
+	// with a handler, the hook is called as well
+	called := false
+	skill.Handlers[dialog.SessionEndedRequest] = func(c *alexa.Context) {
+		called = true
+		c.Tell("nobody hears this either")
+	}
+	ended = nil
+	req.Request.Reason, req.Request.Error = dialog.ReasonUserInitiated, nil
+	resp, err = skill.Handle(context.Background(), &req)
+	test.NoError(err)
+	test.True(called)
+	test.Equal(&alexa.SessionEnded{Reason: dialog.ReasonUserInitiated}, ended)
+	test.Nil(resp.Response.OutputSpeech)
+
+	// a panicking handler does not keep the hook from being called
+	skill.Handlers[dialog.SessionEndedRequest] = func(c *alexa.Context) {
+		panic("broken")
+	}
+	ended = nil
+	req.Request.Reason = dialog.ReasonExceededMaxReprompts
+	_, err = skill.Handle(context.Background(), &req)
+	var panicErr *alexa.PanicError
+	test.True(errors.As(err, &panicErr))
+	test.Equal(&alexa.SessionEnded{Reason: dialog.ReasonExceededMaxReprompts}, ended)
+
+	resp, err = skill.Handle(context.Background(), newRequest("en-US", "HelloIntent"))
+	test.NoError(err)
+	test.Equal("<speak>Hello.</speak>", resp.Response.OutputSpeech.SSML)
+}
@@ -0,0 +1,120 @@
+package test_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/api"
+	sdktest "github.com/dasjott/alexa-sdk-go/test"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripper func(*http.Request) *http.Response
+
+func (rt roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return rt(req), nil
+}
+
+func TestSkill(t *testing.T) {
+	test := assert.New(t)
+
+	var called string
+	client := &http.Client{Transport: roundTripper(func(req *http.Request) *http.Response {
+		called = req.URL.String()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"countryCode":"DE","postalCode":"12345"}`)),
+			Header:     http.Header{},
+		}
+	})}
+
+	batman := &alexa.Skill{
+		AppID:         "skill-1",
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{"HELLO": "I am Batman."}},
+		Handlers: alexa.IntentHandlers{
+			"HelloIntent": func(c *alexa.Context) {
+				c.Tell(c.T("HELLO"))
+			},
+		},
+	}
+	alfred := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{"HELLO": "Take care."}},
+		Voice:         "Brian",
+		HTTPClient:    client,
+		Handlers: alexa.IntentHandlers{
+			"HelloIntent": func(c *alexa.Context) {
+				zip, err := alexa.API(c).GetRegionAndZip()
+				test.NoError(err)
+				c.Tell(c.T("HELLO") + " " + zip.PostalCode)
+			},
+		},
+	}
+
+	req := newRequest("en-US", "HelloIntent")
+	req.Context.System.APIEndpoint = "https://api.amazonalexa.com"
+
+	resp, err := batman.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak>I am Batman.</speak>", resp.Response.OutputSpeech.SSML)
+
+	resp, err = alfred.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("<speak><voice name=\"Brian\">Take care. 12345</voice></speak>", resp.Response.OutputSpeech.SSML)
+	test.Contains(called, "countryAndPostalCode")
+
+	req.Context.System.Application.ID = "skill-2"
+	_, err = batman.Handle(context.Background(), req)
+	test.Equal(alexa.ErrInvalidAppID, err)
+}
+
+func TestDeprecatedRequestHandler(t *testing.T) {
+	test := assert.New(t)
+
+	handled := 0
+	sdktest.RequestHandler = func(req *http.Request) *http.Response {
+		handled++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"countryCode":"DE","postalCode":"54321"}`)),
+			Header:     http.Header{},
+		}
+	}
+	defer func() { sdktest.RequestHandler = nil }()
+
+	var zip *api.RegionAndZip
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"HelloIntent": func(c *alexa.Context) {
+				zip, _ = alexa.API(c).GetRegionAndZip()
+				c.Tell("Hello.")
+			},
+		},
+	}
+	req := newRequest("en-US", "HelloIntent")
+	req.Context.System.APIEndpoint = "https://api.amazonalexa.com"
+
+	_, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal(1, handled)
+	if test.NotNil(zip) {
+		test.Equal("54321", zip.PostalCode)
+	}
+
+	// the HTTPClient of the skill takes precedence
+	skill.HTTPClient = &http.Client{Transport: roundTripper(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"countryCode":"DE","postalCode":"12345"}`)),
+			Header:     http.Header{},
+		}
+	})}
+	_, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal(1, handled)
+	test.Equal("12345", zip.PostalCode)
+}
@@ -0,0 +1,219 @@
+package test_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+const playlistSlots = `{
+	"playlist": {
+		"name": "playlist",
+		"value": "road trip",
+		"resolutions": {"resolutionsPerAuthority": [{
+			"authority": "amzn1.er-authority.echo-sdk.amzn1.ask.skill.1.PlaylistType",
+			"status": {"code": "ER_SUCCESS_MATCH"},
+			"values": [{"value": {"name": "travel", "id": "static-travel"}}]
+		}, {
+			"authority": "amzn1.er-authority.echo-sdk.dynamic.amzn1.ask.skill.1.PlaylistType",
+			"status": {"code": "ER_SUCCESS_MATCH"},
+			"values": [{"value": {"name": "Road Trip 2026", "id": "playlist-42"}}]
+		}]}
+	}
+}`
+
+func TestDynamicEntities(t *testing.T) {
+	test := assert.New(t)
+
+	var playlist *alexa.Slot
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"LaunchRequest": func(c *alexa.Context) {
+				c.ReplaceDynamicEntities(dialog.EntityListItem{
+					Name: "PlaylistType",
+					Values: []dialog.Entity{
+						dialog.NewEntity("playlist-42", "Road Trip 2026", "road trip", "car music"),
+					},
+				})
+				c.Ask("Which playlist?")
+			},
+			"PlayIntent": func(c *alexa.Context) {
+				playlist = c.Slot("playlist")
+				c.ClearDynamicEntities()
+				c.Tell("Playing " + playlist.Value)
+			},
+		},
+	}
+
+	req := newRequest("en-US", "LaunchRequest")
+	req.Request.Type = "LaunchRequest"
+	resp, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+	data, _ := json.Marshal(resp.Response.Directives)
+	test.JSONEq(`[{
+		"type": "Dialog.UpdateDynamicEntities",
+		"updateBehavior": "REPLACE",
+		"types": [{"name": "PlaylistType", "values": [{"id": "playlist-42", "name": {"value": "Road Trip 2026", "synonyms": ["road trip", "car music"]}}]}]
+	}]`, string(data))
+
+	req = newRequest("en-US", "PlayIntent")
+	test.NoError(json.Unmarshal([]byte(playlistSlots), &req.Request.Intent.Slots))
+	resp, err = skill.Handle(context.Background(), req)
+	test.NoError(err)
+	test.Equal("playlist-42", playlist.ID)
+	test.Equal("Road Trip 2026", playlist.Value)
+	test.Equal("road trip", playlist.Spoken)
+	test.True(playlist.MatchedAuthority().Dynamic)
+	test.False(playlist.IsAmbiguous())
+	data, _ = json.Marshal(resp.Response.Directives)
+	test.JSONEq(`[{"type": "Dialog.UpdateDynamicEntities", "updateBehavior": "CLEAR"}]`, string(data))
+}
+
+const citySlots = `{
+	"city": {
+		"name": "city",
+		"value": "paris",
+		"resolutions": {"resolutionsPerAuthority": [{
+			"authority": "amzn1.er-authority.echo-sdk.amzn1.ask.skill.1.CityType",
+			"status": {"code": "ER_SUCCESS_MATCH"},
+			"values": [{"value": {"name": "Paris", "id": "paris-fr"}}, {"value": {"name": "Paris", "id": "paris-tx"}}]
+		}, {
+			"authority": "amzn1.er-authority.echo-sdk.dynamic.amzn1.ask.skill.1.CityType",
+			"status": {"code": "ER_SUCCESS_NO_MATCH"}
+		}]}
+	},
+	"color": {
+		"name": "color",
+		"value": "purpleish",
+		"resolutions": {"resolutionsPerAuthority": [{
+			"authority": "amzn1.er-authority.echo-sdk.amzn1.ask.skill.1.ColorType",
+			"status": {"code": "ER_SUCCESS_NO_MATCH"}
+		}]}
+	},
+	"size": {
+		"name": "size",
+		"value": "large",
+		"resolutions": {"resolutionsPerAuthority": [{
+			"authority": "amzn1.er-authority.echo-sdk.amzn1.ask.skill.1.SizeType",
+			"status": {"code": "ER_ERROR_TIMEOUT"}
+		}]}
+	}
+}`
+
+func TestEntityResolution(t *testing.T) {
+	test := assert.New(t)
+
+	var city, color, size *alexa.Slot
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"TravelIntent": func(c *alexa.Context) {
+				city, color, size = c.Slot("city"), c.Slot("color"), c.Slot("size")
+				c.Tell("OK")
+			},
+		},
+	}
+
+	req := newRequest("en-US", "TravelIntent")
+	test.NoError(json.Unmarshal([]byte(citySlots), &req.Request.Intent.Slots))
+	_, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+
+	test.True(city.Match)
+	test.True(city.IsAmbiguous())
+	test.Len(city.Authorities, 2)
+	test.Equal(dialog.ERSuccessNoMatch, city.Authorities[1].Status)
+	test.True(city.Authorities[1].Dynamic)
+	test.False(city.MatchedAuthority().Dynamic)
+	test.Equal("paris-fr", city.BestMatch().ID)
+	test.Equal("paris-fr", city.ID)
+	test.False(city.ResolutionFailed())
+
+	test.False(color.Match)
+	test.False(color.IsAmbiguous())
+	test.Nil(color.MatchedAuthority())
+	test.Nil(color.BestMatch())
+	test.Equal("", color.ID)
+
+	test.False(size.Match)
+	test.True(size.ResolutionFailed())
+}
+
+const grocerySlots = `{
+	"groceries": {
+		"name": "groceries",
+		"confirmationStatus": "NONE",
+		"slotValue": {
+			"type": "List",
+			"values": [{
+				"type": "Simple",
+				"value": "milk",
+				"resolutions": {"resolutionsPerAuthority": [{
+					"authority": "amzn1.er-authority.echo-sdk.amzn1.ask.skill.1.GroceryType",
+					"status": {"code": "ER_SUCCESS_MATCH"},
+					"values": [{"value": {"name": "Milk", "id": "milk"}}]
+				}]}
+			}, {
+				"type": "Simple",
+				"value": "eggs",
+				"resolutions": {"resolutionsPerAuthority": [{
+					"authority": "amzn1.er-authority.echo-sdk.amzn1.ask.skill.1.GroceryType",
+					"status": {"code": "ER_SUCCESS_MATCH"},
+					"values": [{"value": {"name": "Eggs", "id": "eggs"}}]
+				}]}
+			}, {
+				"type": "Simple",
+				"value": "bread"
+			}]
+		}
+	},
+	"store": {
+		"name": "store",
+		"slotValue": {"type": "Simple", "value": "corner shop"}
+	},
+	"empty": {
+		"name": "empty",
+		"slotValue": {"type": "List", "values": []}
+	}
+}`
+
+func TestMultiValueSlots(t *testing.T) {
+	test := assert.New(t)
+
+	var groceries, store, empty *alexa.Slot
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"AddIntent": func(c *alexa.Context) {
+				groceries, store, empty = c.Slot("groceries"), c.Slot("store"), c.Slot("empty")
+				c.Tell("OK")
+			},
+		},
+	}
+
+	req := newRequest("en-US", "AddIntent")
+	test.NoError(json.Unmarshal([]byte(grocerySlots), &req.Request.Intent.Slots))
+	_, err := skill.Handle(context.Background(), req)
+	test.NoError(err)
+
+	test.True(groceries.IsList())
+	test.False(groceries.Empty())
+	test.Len(groceries.Items, 3)
+	test.Equal("milk, eggs, bread", groceries.Spoken)
+	test.Equal("milk", groceries.ID)
+	test.Equal("eggs", groceries.Items[1].ID)
+	test.Equal("Eggs", groceries.Items[1].Value)
+	test.False(groceries.Items[2].Match)
+	test.Equal("bread", groceries.Items[2].Value)
+
+	test.False(store.IsList())
+	test.Equal("corner shop", store.Spoken)
+
+	test.True(empty.IsList())
+	test.True(empty.Empty())
+}
@@ -0,0 +1,142 @@
+package test_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+// slotsAt runs a request with the given slot values at the given time and returns the context
+func slotsAt(timestamp string, values map[string]string) *alexa.Context {
+	var ctx *alexa.Context
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"PlanIntent": func(c *alexa.Context) {
+				ctx = c
+				c.Tell("ok")
+			},
+		},
+	}
+
+	req := newRequest("en-US", "PlanIntent")
+	req.Request.Timestamp = timestamp
+	req.Request.Intent.Slots = map[string]dialog.EchoSlot{}
+	for name, value := range values {
+		req.Request.Intent.Slots[name] = dialog.EchoSlot{Name: name, Value: value}
+	}
+	skill.Handle(context.Background(), req)
+	return ctx
+}
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSlotDate(t *testing.T) {
+	test := assert.New(t)
+
+	cases := []struct {
+		value       string
+		start, end  time.Time
+		granularity string
+	}{
+		{"2026-10-18", date(2026, 10, 18), date(2026, 10, 19), alexa.GranularityDay},
+		{"PRESENT_REF", date(2026, 10, 18), date(2026, 10, 19), alexa.GranularityDay},
+		{"XXXX-12-25", date(2026, 12, 25), date(2026, 12, 26), alexa.GranularityDay},
+		{"XXXX-03-01", date(2027, 3, 1), date(2027, 3, 2), alexa.GranularityDay},
+		{"XXXX-02-29", date(2028, 2, 29), date(2028, 3, 1), alexa.GranularityDay},
+		{"XXXX-W43", date(2026, 10, 19), date(2026, 10, 26), alexa.GranularityWeek},
+		{"XXXX-W01", date(2027, 1, 4), date(2027, 1, 11), alexa.GranularityWeek},
+		{"XXXX-W01-WE", date(2027, 1, 9), date(2027, 1, 11), alexa.GranularityWeekend},
+		{"2026-W43", date(2026, 10, 19), date(2026, 10, 26), alexa.GranularityWeek},
+		{"2026-W43-WE", date(2026, 10, 24), date(2026, 10, 26), alexa.GranularityWeekend},
+		{"2026-W01", date(2025, 12, 29), date(2026, 1, 5), alexa.GranularityWeek},
+		{"2026-10", date(2026, 10, 1), date(2026, 11, 1), alexa.GranularityMonth},
+		{"XXXX-02", date(2027, 2, 1), date(2027, 3, 1), alexa.GranularityMonth},
+		{"2026-WI", date(2026, 12, 1), date(2027, 3, 1), alexa.GranularitySeason},
+		{"2026", date(2026, 1, 1), date(2027, 1, 1), alexa.GranularityYear},
+		{"201X", date(2010, 1, 1), date(2020, 1, 1), alexa.GranularityDecade},
+	}
+
+	for _, tc := range cases {
+		c := slotsAt("2026-10-18T09:30:00Z", map[string]string{"when": tc.value})
+		r, err := c.Slot("when").Date()
+		if test.NoError(err, tc.value) {
+			test.Equal(tc.start, r.Start, tc.value)
+			test.Equal(tc.end, r.End, tc.value)
+			test.Equal(tc.granularity, r.Granularity, tc.value)
+		}
+	}
+
+	c := slotsAt("2026-10-18T09:30:00Z", map[string]string{"unknown": "?", "invalid": "2026-13", "empty": "", "never": "XXXX-02-30"})
+	_, err := c.Slot("unknown").Date()
+	test.True(errors.Is(err, alexa.ErrUnknownValue))
+	_, err = c.Slot("invalid").Date()
+	test.True(errors.Is(err, alexa.ErrInvalidValue))
+	_, err = c.Slot("never").Date()
+	test.True(errors.Is(err, alexa.ErrInvalidValue))
+	_, err = c.Slot("empty").Date()
+	test.True(errors.Is(err, alexa.ErrEmptySlot))
+	_, err = c.Slot("missing").Date()
+	test.True(errors.Is(err, alexa.ErrEmptySlot))
+
+	r, _ := c.Slot("when").Date()
+	test.Nil(r)
+	r, _ = slotsAt("2026-10-18T09:30:00Z", map[string]string{"when": "2026-W42"}).Slot("when").Date()
+	test.True(r.Contains(date(2026, 10, 18)))
+	test.False(r.Contains(date(2026, 10, 19)))
+}
+
+func TestSlotTimeDurationNumber(t *testing.T) {
+	test := assert.New(t)
+
+	c := slotsAt("2026-10-18T09:30:00Z", map[string]string{
+		"at":       "14:30",
+		"morning":  "MO",
+		"duration": "PT1H30M",
+		"long":     "P1Y2W",
+		"count":    "42",
+		"amount":   "2.5",
+		"unknown":  "?",
+		"invalid":  "ten",
+	})
+
+	tod, err := c.Slot("at").TimeOfDay()
+	test.NoError(err)
+	test.Equal(&alexa.TimeOfDay{Hour: 14, Minute: 30}, tod)
+	test.Equal(time.Date(2026, 10, 18, 14, 30, 0, 0, time.UTC), tod.On(c.Time))
+
+	tod, err = c.Slot("morning").TimeOfDay()
+	test.NoError(err)
+	test.Equal(alexa.PeriodMorning, tod.Period)
+	_, err = c.Slot("invalid").TimeOfDay()
+	test.True(errors.Is(err, alexa.ErrInvalidValue))
+
+	d, err := c.Slot("duration").Duration()
+	test.NoError(err)
+	test.Equal(90*time.Minute, d)
+	d, err = c.Slot("long").Duration()
+	test.NoError(err)
+	test.Equal((365+14)*24*time.Hour, d)
+	_, err = c.Slot("invalid").Duration()
+	test.True(errors.Is(err, alexa.ErrInvalidValue))
+	_, err = c.Slot("unknown").Duration()
+	test.True(errors.Is(err, alexa.ErrUnknownValue))
+
+	n, err := c.Slot("count").Int()
+	test.NoError(err)
+	test.Equal(42, n)
+	f, err := c.Slot("amount").Float()
+	test.NoError(err)
+	test.Equal(2.5, f)
+	_, err = c.Slot("unknown").Int()
+	test.True(errors.Is(err, alexa.ErrUnknownValue))
+	_, err = c.Slot("invalid").Int()
+	test.True(errors.Is(err, alexa.ErrInvalidValue))
+}
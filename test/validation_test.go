@@ -0,0 +1,91 @@
+package test_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlotValidation(t *testing.T) {
+	test := assert.New(t)
+
+	called := false
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{
+			"ASK_GUESTS": "For how many guests? One to eight.",
+			"ASK_DATE":   "For which day within the next month?",
+			"ASK_ROOM":   "Single or double room?",
+			"ASK_NAME":   "Under which name?",
+		}},
+		Handlers: alexa.IntentHandlers{
+			"BookIntent": func(c *alexa.Context) {
+				called = true
+				c.Tell("Booked.")
+			},
+		},
+		Validation: alexa.SlotValidation{
+			"BookIntent": {
+				alexa.NumberRange("guests", "ASK_GUESTS", 1, 8),
+				alexa.DateWindow("date", "ASK_DATE", 0, 31*24*time.Hour),
+				alexa.AllowedIDs("room", "ASK_ROOM", "single", "double"),
+				alexa.SlotFunc("name", "ASK_NAME", func(s *alexa.Slot) bool { return len(s.Value) > 1 }),
+			},
+		},
+	}
+
+	book := func(slots map[string]string) *dialog.EchoResponse {
+		called = false
+		req := newRequest("en-US", "BookIntent")
+		req.Request.Timestamp = "2026-10-18T09:30:00Z"
+		req.Request.Intent.ConfirmationStatus = alexa.ConfirmationStatusNone
+		req.Request.Intent.Slots = map[string]dialog.EchoSlot{}
+		for name, value := range slots {
+			req.Request.Intent.Slots[name] = dialog.EchoSlot{Name: name, Value: value}
+		}
+		resp, err := skill.Handle(context.Background(), req)
+		test.NoError(err)
+		return resp
+	}
+	elicited := func(resp *dialog.EchoResponse) *dialog.SlotDirective {
+		if test.Len(resp.Response.Directives, 1) {
+			directive := resp.Response.Directives[0].(dialog.SlotDirective)
+			return &directive
+		}
+		return &dialog.SlotDirective{}
+	}
+
+	// empty slots are left to the dialog model
+	book(map[string]string{"guests": "2"})
+	test.True(called)
+
+	resp := book(map[string]string{"guests": "12", "name": "Smith"})
+	test.False(called)
+	directive := elicited(resp)
+	test.Equal("Dialog.ElicitSlot", directive.Type)
+	test.Equal("guests", directive.SlotToElicit)
+	test.Equal("<speak>For how many guests? One to eight.</speak>", resp.Response.OutputSpeech.SSML)
+	test.Equal("", directive.UpdatedIntent.Slots["guests"].Value)
+	test.Equal("Smith", directive.UpdatedIntent.Slots["name"].Value)
+
+	resp = book(map[string]string{"guests": "?"})
+	test.Equal("guests", elicited(resp).SlotToElicit)
+
+	resp = book(map[string]string{"date": "2026-10-17"})
+	test.Equal("date", elicited(resp).SlotToElicit)
+	book(map[string]string{"date": "PRESENT_REF"})
+	test.True(called)
+	resp = book(map[string]string{"date": "2026-12"})
+	test.Equal("date", elicited(resp).SlotToElicit)
+
+	resp = book(map[string]string{"name": "X"})
+	test.Equal("name", elicited(resp).SlotToElicit)
+
+	// the room slot has no resolved ID
+	resp = book(map[string]string{"room": "suite"})
+	test.Equal("room", elicited(resp).SlotToElicit)
+	test.Equal("<speak>Single or double room?</speak>", resp.Response.Reprompt.OutputSpeech.SSML)
+}
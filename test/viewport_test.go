@@ -0,0 +1,88 @@
+package test_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/dasjott/alexa-sdk-go"
+	"github.com/dasjott/alexa-sdk-go/dialog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewportProfile(t *testing.T) {
+	test := assert.New(t)
+
+	viewport := func(shape, mode string, width, height, dpi int) *dialog.EchoViewport {
+		return &dialog.EchoViewport{Shape: shape, Mode: mode, CurrentPixelWidth: width, CurrentPixelHeight: height, DPI: dpi}
+	}
+
+	test.Equal(alexa.ViewportNoDisplay, alexa.GetViewportProfile(nil))
+	test.Equal(alexa.ViewportHubRoundSmall, alexa.GetViewportProfile(viewport(dialog.ShapeRound, dialog.ModeHub, 480, 480, 160)))
+	test.Equal(alexa.ViewportHubLandscapeSmall, alexa.GetViewportProfile(viewport(dialog.ShapeRectangle, dialog.ModeHub, 960, 480, 160)))
+	test.Equal(alexa.ViewportHubLandscapeMedium, alexa.GetViewportProfile(viewport(dialog.ShapeRectangle, dialog.ModeHub, 1024, 600, 160)))
+	test.Equal(alexa.ViewportHubLandscapeLarge, alexa.GetViewportProfile(viewport(dialog.ShapeRectangle, dialog.ModeHub, 1280, 800, 160)))
+	test.Equal(alexa.ViewportHubLandscapeXLarge, alexa.GetViewportProfile(viewport(dialog.ShapeRectangle, dialog.ModeHub, 1920, 1200, 160)))
+	test.Equal(alexa.ViewportHubPortraitMedium, alexa.GetViewportProfile(viewport(dialog.ShapeRectangle, dialog.ModeHub, 800, 1280, 160)))
+	test.Equal(alexa.ViewportTVLandscapeXLarge, alexa.GetViewportProfile(viewport(dialog.ShapeRectangle, dialog.ModeTV, 1920, 1080, 320)))
+	test.Equal(alexa.ViewportHubLandscapeLarge, alexa.GetViewportProfile(viewport(dialog.ShapeRectangle, dialog.ModeHub, 2560, 1600, 320)))
+}
+
+const showRequest = `{
+	"version": "1.0",
+	"session": {"new": true, "sessionId": "session-1"},
+	"context": {
+		"System": {
+			"application": {"applicationId": "skill-1"},
+			"device": {"deviceId": "device-1", "supportedInterfaces": {"Alexa.Presentation.APL": {"runtime": {"maxVersion": "1.6"}}}}
+		},
+		"Viewport": {
+			"experiences": [{"arcMinuteWidth": 246, "arcMinuteHeight": 144, "canRotate": false, "canResize": false}],
+			"mode": "HUB",
+			"shape": "RECTANGLE",
+			"pixelWidth": 1024,
+			"pixelHeight": 600,
+			"dpi": 160,
+			"currentPixelWidth": 1024,
+			"currentPixelHeight": 600,
+			"touch": ["SINGLE"]
+		},
+		"Viewports": [{"type": "APL", "id": "main", "shape": "RECTANGLE", "dpi": 160, "presentationType": "STANDARD"}],
+		"Alexa.Presentation.APL": {"token": "episodes", "version": "1.6"}
+	},
+	"request": {"type": "LaunchRequest", "requestId": "request-1", "locale": "en-US"}
+}`
+
+func TestDeviceCapabilities(t *testing.T) {
+	test := assert.New(t)
+
+	var profile alexa.ViewportProfile
+	var apl, video bool
+	skill := &alexa.Skill{
+		LocaleStrings: alexa.Localisation{"en-US": alexa.Translation{}},
+		Handlers: alexa.IntentHandlers{
+			"LaunchRequest": func(c *alexa.Context) {
+				profile = c.ViewportProfile()
+				apl = c.Supports(dialog.InterfaceAPL)
+				video = c.Supports(dialog.InterfaceVideoApp)
+				c.Tell("Hello")
+			},
+		},
+	}
+
+	var req dialog.EchoRequest
+	test.NoError(json.Unmarshal([]byte(showRequest), &req))
+	_, err := skill.Handle(context.Background(), &req)
+	test.NoError(err)
+	test.Equal(alexa.ViewportHubLandscapeMedium, profile)
+	test.True(apl)
+	test.False(video)
+	test.Equal("episodes", req.Context.APL.Token)
+	test.Equal("main", req.Context.Viewports[0].ID)
+	test.Equal([]string{"SINGLE"}, req.Context.Viewport.Touch)
+
+	_, err = skill.Handle(context.Background(), newRequest("en-US", "LaunchRequest"))
+	test.NoError(err)
+	test.Equal(alexa.ViewportNoDisplay, profile)
+	test.False(apl)
+}
@@ -0,0 +1,98 @@
+package alexa
+
+import (
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go/dialog"
+)
+
+// SlotValidation are the rules for the slots of each intent, by intent name
+type SlotValidation map[string][]SlotRule
+
+// SlotRule validates the value of one slot.
+// If the slot is invalid, it is elicited again with the translated Prompt and the intents handler is not called.
+type SlotRule struct {
+	// Slot is the name of the slot
+	Slot string
+	// Prompt is the key of the translation to elicit the slot again with. It is used as reprompt as well.
+	Prompt string
+	// Valid determines whether the value of the slot is valid. Empty slots are not validated.
+	Valid func(s *Slot) bool
+}
+
+// AllowedIDs accepts the slot, if its resolved ID is one of ids. For multi value slots every item must be.
+func AllowedIDs(slot, prompt string, ids ...string) SlotRule {
+	return SlotFunc(slot, prompt, func(s *Slot) bool {
+		items := s.Items
+		if items == nil {
+			items = []*Slot{s}
+		}
+		for _, item := range items {
+			if !contains(ids, item.ID) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// NumberRange accepts the slot, if it is a number from min to max
+func NumberRange(slot, prompt string, min, max float64) SlotRule {
+	return SlotFunc(slot, prompt, func(s *Slot) bool {
+		n, err := s.Float()
+		return err == nil && n >= min && n <= max
+	})
+}
+
+// DateWindow accepts the slot, if its date range overlaps the window from..to, relative to the time of the request.
+// So DateWindow("date", "ASK_DATE_AGAIN", 0, 30*24*time.Hour) accepts today and the next 30 days.
+func DateWindow(slot, prompt string, from, to time.Duration) SlotRule {
+	return SlotFunc(slot, prompt, func(s *Slot) bool {
+		r, err := s.Date()
+		if err != nil {
+			return false
+		}
+		now := s.reference()
+		return r.End.After(now.Add(from)) && r.Start.Before(now.Add(to))
+	})
+}
+
+// SlotFunc accepts the slot, if valid returns true
+func SlotFunc(slot, prompt string, valid func(s *Slot) bool) SlotRule {
+	return SlotRule{Slot: slot, Prompt: prompt, Valid: valid}
+}
+
+// check determines whether the slot passes the rule
+func (r *SlotRule) check(s *Slot) bool {
+	return s.Empty() || r.Valid == nil || r.Valid(s)
+}
+
+// invalidSlot returns the first rule of the current intent, which is not met
+func (c *Context) invalidSlot() *SlotRule {
+	if c.request.GetRequestType() != "IntentRequest" {
+		return nil
+	}
+	rules := c.skill.Validation[c.Intent.Name]
+	for i := range rules {
+		if !rules[i].check(c.Slot(rules[i].Slot)) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// elicitInvalid elicits the slot of rule again, having the invalid value removed from the intent
+func (c *Context) elicitInvalid(rule *SlotRule) {
+	intent := &dialog.EchoIntent{
+		Name:               c.Intent.Name,
+		ConfirmationStatus: c.Intent.ConfirmationStatus,
+		Slots:              make(map[string]dialog.EchoSlot, len(c.Intent.Slots)),
+	}
+	for name, slot := range c.Intent.Slots {
+		if name == rule.Slot {
+			slot = dialog.EchoSlot{Name: name, ConfirmationStatus: ConfirmationStatusNone}
+		}
+		intent.Slots[name] = slot
+	}
+	c.ElicitSlot(rule.Slot, c.T(rule.Prompt), c.T(rule.Prompt), intent)
+}
@@ -0,0 +1,124 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CertFetcher loads the certificate chain found at the given url.
+// The first certificate is the signing certificate, the following ones are intermediates.
+// A download is cancelled, if ctx is done.
+type CertFetcher interface {
+	Fetch(ctx context.Context, url string) ([]*x509.Certificate, error)
+}
+
+// FetchTimeout is the time a download of a certificate chain may take with the default client.
+// Alexa waits about 8 seconds for the whole response.
+const FetchTimeout = 3 * time.Second
+
+// defaultClient downloads the certificate chains, if the HTTPFetcher has no Client
+var defaultClient = &http.Client{Timeout: FetchTimeout}
+
+// HTTPFetcher downloads certificate chains via http
+type HTTPFetcher struct {
+	// Client is used for downloading. If nil, a client with a timeout of FetchTimeout is used.
+	Client *http.Client
+}
+
+// Fetch downloads and parses the PEM encoded certificate chain at url
+func (f *HTTPFetcher) Fetch(ctx context.Context, url string) ([]*x509.Certificate, error) {
+	client := f.Client
+	if client == nil {
+		client = defaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching certificate: response code %d", resp.StatusCode)
+	}
+
+	buf := bytes.Buffer{}
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return nil, err
+	}
+	return ParseChain(buf.Bytes())
+}
+
+// CachingFetcher remembers chains fetched by another CertFetcher until the signing certificate expires
+type CachingFetcher struct {
+	fetcher CertFetcher
+	mutex   sync.Mutex
+	chains  map[string][]*x509.Certificate
+}
+
+// NewCachingFetcher creates a CachingFetcher in front of the given fetcher
+func NewCachingFetcher(fetcher CertFetcher) *CachingFetcher {
+	return &CachingFetcher{
+		fetcher: fetcher,
+		chains:  make(map[string][]*x509.Certificate),
+	}
+}
+
+// Fetch returns the cached chain for url or fetches it
+func (f *CachingFetcher) Fetch(ctx context.Context, url string) ([]*x509.Certificate, error) {
+	f.mutex.Lock()
+	chain, exists := f.chains[url]
+	f.mutex.Unlock()
+
+	if exists && time.Now().Before(chain[0].NotAfter) {
+		return chain, nil
+	}
+
+	chain, err := f.fetcher.Fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if len(chain) == 0 {
+		return nil, ErrCertificate
+	}
+
+	f.mutex.Lock()
+	f.chains[url] = chain
+	f.mutex.Unlock()
+	return chain, nil
+}
+
+// ParseChain parses all PEM encoded certificates in data
+func ParseChain(data []byte) ([]*x509.Certificate, error) {
+	var chain []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	return chain, nil
+}
@@ -0,0 +1,173 @@
+package verify
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// header names of the signature information sent by Alexa
+const (
+	HeaderCertChainURL = "SignatureCertChainUrl"
+	HeaderSignature256 = "Signature-256"
+	HeaderSignature    = "Signature"
+)
+
+const (
+	certHost = "s3.amazonaws.com"
+	certPath = "/echo.api/"
+	echoSAN  = "echo-api.amazon.com"
+)
+
+var (
+	// ErrCertURL is returned if the SignatureCertChainUrl does not meet Amazons rules
+	ErrCertURL = errors.New("invalid signature certificate url")
+	// ErrCertificate is returned if the certificate chain is not valid
+	ErrCertificate = errors.New("invalid signature certificate")
+	// ErrSignature is returned if the body does not match the signature
+	ErrSignature = errors.New("invalid signature")
+)
+
+// Verifier checks whether a request was actually sent by Alexa
+type Verifier struct {
+	// Fetcher loads the certificate chains. Use a CachingFetcher to avoid downloads on every request.
+	Fetcher CertFetcher
+	// Roots to verify the chains against. If nil, the systems roots are used.
+	Roots *x509.CertPool
+	// Now returns the time to check the certificates validity with. If nil, time.Now is used.
+	Now func() time.Time
+}
+
+// New creates a Verifier downloading and caching certificates from Amazon
+func New() *Verifier {
+	return &Verifier{
+		Fetcher: NewCachingFetcher(&HTTPFetcher{}),
+	}
+}
+
+// VerifyRequest verifies the body by using the signature headers of the request.
+// Signature-256 is preferred, the deprecated Signature header is only used if the former is missing.
+func (v *Verifier) VerifyRequest(header http.Header, body []byte) error {
+	return v.VerifyRequestContext(context.Background(), header, body)
+}
+
+// VerifyRequestContext is VerifyRequest, cancelling the download of the certificate chain if ctx is done
+func (v *Verifier) VerifyRequestContext(ctx context.Context, header http.Header, body []byte) error {
+	certURL := header.Get(HeaderCertChainURL)
+	if sig := header.Get(HeaderSignature256); sig != "" {
+		return v.verify(ctx, certURL, sig, crypto.SHA256, body)
+	}
+	if sig := header.Get(HeaderSignature); sig != "" {
+		return v.verify(ctx, certURL, sig, crypto.SHA1, body)
+	}
+	return fmt.Errorf("%w: header missing", ErrSignature)
+}
+
+// Verify checks the url, the certificate chain found there and the SHA-256 signature of body
+func (v *Verifier) Verify(certURL, signature string, body []byte) error {
+	return v.verify(context.Background(), certURL, signature, crypto.SHA256, body)
+}
+
+func (v *Verifier) verify(ctx context.Context, certURL, signature string, hash crypto.Hash, body []byte) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if err := VerifyCertURL(certURL); err != nil {
+		return err
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrSignature, err.Error())
+	}
+
+	fetcher := v.Fetcher
+	if fetcher == nil {
+		fetcher = &HTTPFetcher{}
+	}
+	chain, err := fetcher.Fetch(ctx, certURL)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrCertificate, err.Error())
+	}
+	if err = v.verifyChain(chain); err != nil {
+		return err
+	}
+
+	pub, ok := chain[0].PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("%w: no rsa key", ErrCertificate)
+	}
+
+	var digest []byte
+	if hash == crypto.SHA1 {
+		sum := sha1.Sum(body)
+		digest = sum[:]
+	} else {
+		sum := sha256.Sum256(body)
+		digest = sum[:]
+	}
+	if err = rsa.VerifyPKCS1v15(pub, hash, digest, sig); err != nil {
+		return ErrSignature
+	}
+	return nil
+}
+
+func (v *Verifier) verifyChain(chain []*x509.Certificate) error {
+	if len(chain) == 0 {
+		return fmt.Errorf("%w: empty chain", ErrCertificate)
+	}
+
+	now := time.Now()
+	if v.Now != nil {
+		now = v.Now()
+	}
+
+	opts := x509.VerifyOptions{
+		DNSName:       echoSAN,
+		Roots:         v.Roots,
+		Intermediates: x509.NewCertPool(),
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	for _, cert := range chain[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	if _, err := chain[0].Verify(opts); err != nil {
+		return fmt.Errorf("%w: %s", ErrCertificate, err.Error())
+	}
+	return nil
+}
+
+// VerifyCertURL checks the SignatureCertChainUrl according to Amazons rules
+func VerifyCertURL(certURL string) error {
+	u, err := url.Parse(certURL)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrCertURL, err.Error())
+	}
+	if !strings.EqualFold(u.Scheme, "https") {
+		return fmt.Errorf("%w: scheme %q", ErrCertURL, u.Scheme)
+	}
+	if !strings.EqualFold(u.Hostname(), certHost) {
+		return fmt.Errorf("%w: host %q", ErrCertURL, u.Hostname())
+	}
+	if port := u.Port(); port != "" && port != "443" {
+		return fmt.Errorf("%w: port %q", ErrCertURL, port)
+	}
+	if !strings.HasPrefix(path.Clean(u.Path), certPath) {
+		return fmt.Errorf("%w: path %q", ErrCertURL, u.Path)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package verify_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dasjott/alexa-sdk-go/verify"
+	"github.com/stretchr/testify/assert"
+)
+
+const certURL = "https://s3.amazonaws.com/echo.api/echo-api-cert.pem"
+
+type countingFetcher struct {
+	chain []byte
+	count int
+}
+
+func (f *countingFetcher) Fetch(ctx context.Context, url string) ([]*x509.Certificate, error) {
+	f.count++
+	return verify.ParseChain(f.chain)
+}
+
+func newCert(t *testing.T, tmpl *x509.Certificate, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return cert, key
+}
+
+func setup(t *testing.T, san string) (*verify.Verifier, *countingFetcher, *rsa.PrivateKey) {
+	root, rootKey := newCert(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Root"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	leaf, leafKey := newCert(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: san},
+		DNSNames:     []string{san},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}, root, rootKey)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	fetcher := &countingFetcher{
+		chain: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.Raw}),
+	}
+	v := &verify.Verifier{
+		Fetcher: verify.NewCachingFetcher(fetcher),
+		Roots:   roots,
+	}
+	return v, fetcher, leafKey
+}
+
+func sign(key *rsa.PrivateKey, body []byte) string {
+	sum := sha256.Sum256(body)
+	sig, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestCertURL(t *testing.T) {
+	test := assert.New(t)
+
+	test.NoError(verify.VerifyCertURL("https://s3.amazonaws.com/echo.api/echo-api-cert.pem"))
+	test.NoError(verify.VerifyCertURL("https://s3.amazonaws.com:443/echo.api/echo-api-cert.pem"))
+	test.NoError(verify.VerifyCertURL("HTTPS://s3.AmazonAWS.com/echo.api/../echo.api/echo-api-cert.pem"))
+
+	test.True(errors.Is(verify.VerifyCertURL("http://s3.amazonaws.com/echo.api/echo-api-cert.pem"), verify.ErrCertURL))
+	test.True(errors.Is(verify.VerifyCertURL("https://notamazon.com/echo.api/echo-api-cert.pem"), verify.ErrCertURL))
+	test.True(errors.Is(verify.VerifyCertURL("https://s3.amazonaws.com/EcHo.aPi/echo-api-cert.pem"), verify.ErrCertURL))
+	test.True(errors.Is(verify.VerifyCertURL("https://s3.amazonaws.com/invalid.path/echo-api-cert.pem"), verify.ErrCertURL))
+	test.True(errors.Is(verify.VerifyCertURL("https://s3.amazonaws.com/echo.api/../invalid.path/echo-api-cert.pem"), verify.ErrCertURL))
+	test.True(errors.Is(verify.VerifyCertURL("https://s3.amazonaws.com:563/echo.api/echo-api-cert.pem"), verify.ErrCertURL))
+}
+
+func TestSignature(t *testing.T) {
+	test := assert.New(t)
+
+	v, fetcher, key := setup(t, "echo-api.amazon.com")
+	body := []byte(`{"version":"1.0"}`)
+
+	header := http.Header{}
+	header.Set(verify.HeaderCertChainURL, certURL)
+	header.Set(verify.HeaderSignature256, sign(key, body))
+
+	test.NoError(v.VerifyRequest(header, body))
+	test.NoError(v.Verify(certURL, sign(key, body), body))
+	test.Equal(1, fetcher.count)
+
+	test.True(errors.Is(v.VerifyRequest(header, []byte(`{"version":"2.0"}`)), verify.ErrSignature))
+	test.True(errors.Is(v.VerifyRequest(http.Header{}, body), verify.ErrSignature))
+}
+
+func TestCertificate(t *testing.T) {
+	test := assert.New(t)
+
+	v, _, key := setup(t, "evil.example.com")
+	body := []byte(`{"version":"1.0"}`)
+	test.True(errors.Is(v.Verify(certURL, sign(key, body), body), verify.ErrCertificate))
+
+	v, _, key = setup(t, "echo-api.amazon.com")
+	v.Now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	test.True(errors.Is(v.Verify(certURL, sign(key, body), body), verify.ErrCertificate))
+
+	v, _, key = setup(t, "echo-api.amazon.com")
+	v.Roots = x509.NewCertPool()
+	test.True(errors.Is(v.Verify(certURL, sign(key, body), body), verify.ErrCertificate))
+}
+
+// blockingTransport answers only when the context of the request is done
+type blockingTransport struct{}
+
+func (blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestFetchContext(t *testing.T) {
+	test := assert.New(t)
+
+	_, _, key := setup(t, "echo-api.amazon.com")
+	v := &verify.Verifier{Fetcher: &verify.HTTPFetcher{Client: &http.Client{Transport: blockingTransport{}}}}
+	body := []byte(`{"version":"1.0"}`)
+	header := http.Header{}
+	header.Set(verify.HeaderCertChainURL, certURL)
+	header.Set(verify.HeaderSignature256, sign(key, body))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	test.True(errors.Is(v.VerifyRequestContext(ctx, header, body), verify.ErrCertificate))
+	test.Less(time.Since(start), time.Second)
+}
@@ -0,0 +1,120 @@
+package alexa
+
+import "github.com/dasjott/alexa-sdk-go/dialog"
+
+// ViewportProfile is a class of devices with similar screens
+type ViewportProfile string
+
+// viewport profiles as defined by Amazon for APL
+const (
+	ViewportHubRoundSmall      ViewportProfile = "HUB_ROUND_SMALL"
+	ViewportHubLandscapeSmall  ViewportProfile = "HUB_LANDSCAPE_SMALL"
+	ViewportHubLandscapeMedium ViewportProfile = "HUB_LANDSCAPE_MEDIUM"
+	ViewportHubLandscapeLarge  ViewportProfile = "HUB_LANDSCAPE_LARGE"
+	ViewportHubLandscapeXLarge ViewportProfile = "HUB_LANDSCAPE_XLARGE"
+	ViewportHubPortraitMedium  ViewportProfile = "HUB_PORTRAIT_MEDIUM"
+	ViewportTVLandscapeXLarge  ViewportProfile = "TV_LANDSCAPE_XLARGE"
+	ViewportMobileSmall        ViewportProfile = "MOBILE_SMALL"
+	ViewportMobileMedium       ViewportProfile = "MOBILE_MEDIUM"
+	ViewportMobileLarge        ViewportProfile = "MOBILE_LARGE"
+	ViewportNoDisplay          ViewportProfile = "NO_DISPLAY"
+	ViewportUnknown            ViewportProfile = "UNKNOWN"
+)
+
+// size classes of a viewport dimension in dp, as used by APL
+const (
+	sizeXSmall = iota // below 600dp
+	sizeSmall         // below 960dp
+	sizeMedium        // below 1280dp
+	sizeLarge         // below 1920dp
+	sizeXLarge
+)
+
+func sizeClass(dp int) int {
+	switch {
+	case dp < 600:
+		return sizeXSmall
+	case dp < 960:
+		return sizeSmall
+	case dp < 1280:
+		return sizeMedium
+	case dp < 1920:
+		return sizeLarge
+	}
+	return sizeXLarge
+}
+
+// Supports determines whether the device supports the given interface, e.g. dialog.InterfaceAPL
+func (c *Context) Supports(name string) bool {
+	return c.System.Device.Supports(name)
+}
+
+// Viewport gets the screen of the device. It is nil for devices without a screen.
+func (c *Context) Viewport() *dialog.EchoViewport {
+	return c.request.Context.Viewport
+}
+
+// ViewportProfile classifies the screen of the device by shape, mode and size.
+func (c *Context) ViewportProfile() ViewportProfile {
+	return GetViewportProfile(c.Viewport())
+}
+
+// GetViewportProfile classifies a viewport by shape, mode and size.
+// Sizes are taken in dp, so the pixels are scaled by the dpi of the screen.
+func GetViewportProfile(vp *dialog.EchoViewport) ViewportProfile {
+	if vp == nil {
+		return ViewportNoDisplay
+	}
+
+	width, height := vp.CurrentPixelWidth, vp.CurrentPixelHeight
+	if width == 0 || height == 0 {
+		width, height = vp.PixelWidth, vp.PixelHeight
+	}
+	if width == 0 || height == 0 {
+		return ViewportNoDisplay
+	}
+	dpi := vp.DPI
+	if dpi == 0 {
+		dpi = 160
+	}
+	w, h := sizeClass(width*160/dpi), sizeClass(height*160/dpi)
+
+	if vp.Shape == dialog.ShapeRound {
+		if w == sizeXSmall && h == sizeXSmall {
+			return ViewportHubRoundSmall
+		}
+		return ViewportUnknown
+	}
+
+	switch vp.Mode {
+	case dialog.ModeTV:
+		return ViewportTVLandscapeXLarge
+	case dialog.ModeMobile:
+		switch {
+		case w <= sizeXSmall && h <= sizeSmall, h <= sizeXSmall && w <= sizeSmall:
+			return ViewportMobileSmall
+		case w <= sizeSmall && h <= sizeMedium, h <= sizeSmall && w <= sizeMedium:
+			return ViewportMobileMedium
+		}
+		return ViewportMobileLarge
+	}
+
+	if height > width {
+		if w <= sizeSmall && h >= sizeMedium {
+			return ViewportHubPortraitMedium
+		}
+		return ViewportUnknown
+	}
+
+	switch {
+	case w == sizeMedium && h == sizeXSmall:
+		return ViewportHubLandscapeSmall
+	case w == sizeMedium && h == sizeSmall:
+		return ViewportHubLandscapeMedium
+	case w == sizeLarge && h <= sizeSmall:
+		return ViewportHubLandscapeLarge
+	case w == sizeXLarge:
+		return ViewportHubLandscapeXLarge
+	}
+	return ViewportUnknown
+}